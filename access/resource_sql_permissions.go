@@ -2,6 +2,7 @@ package access
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -25,11 +26,15 @@ type SqlPermissions struct {
 	AnyFile              bool                  `json:"any_file,omitempty" tf:"force_new"`
 	AnonymousFunction    bool                  `json:"anonymous_function,omitempty" tf:"force_new"`
 	ClusterID            string                `json:"cluster_id,omitempty" tf:"computed"`
+	WarehouseID          string                `json:"warehouse_id,omitempty"`
 	PrivilegeAssignments []PrivilegeAssignment `json:"privilege_assignments,omitempty" tf:"slice_set"`
 
-	exec common.CommandExecutor
+	exec common.StatementExecutor
 }
 
+// hiveMetastoreCatalog is the catalog with objects protected by legacy table ACLs
+const hiveMetastoreCatalog = "hive_metastore"
+
 // PrivilegeAssignment ...
 type PrivilegeAssignment struct {
 	Principal  string   `json:"principal"`
@@ -111,17 +116,24 @@ func loadTableACL(id string) (SqlPermissions, error) {
 	return ta, nil
 }
 
-func (ta *SqlPermissions) read() error {
+func (ta *SqlPermissions) read(ctx context.Context) error {
 	thisType, thisKey := ta.typeAndKey()
 	if thisType == "" && thisKey == "" {
 		return fmt.Errorf("invalid ID")
 	}
-	currentGrantsOnThis := ta.exec.Execute(ta.ClusterID, "sql", fmt.Sprintf(
-		"SHOW GRANT ON %s %s", thisType, thisKey))
-	if currentGrantsOnThis.Failed() {
-		failure := currentGrantsOnThis.Error()
-		if strings.Contains(failure, "does not exist") ||
-			strings.Contains(failure, "RESOURCE_DOES_NOT_EXIST") {
+	currentGrantsOnThis, err := ta.exec.Execute(ctx, fmt.Sprintf("SHOW GRANT ON %s %s", thisType, thisKey))
+	if err != nil {
+		failure := err.Error()
+		var statementErr *common.StatementError
+		if errors.As(err, &statementErr) {
+			// errors of clusters are reported as is
+			failure = statementErr.Message
+		}
+		if apierr.IsMissing(err) ||
+			strings.Contains(failure, "does not exist") ||
+			strings.Contains(failure, "RESOURCE_DOES_NOT_EXIST") ||
+			strings.Contains(failure, "TABLE_OR_VIEW_NOT_FOUND") ||
+			strings.Contains(failure, "SCHEMA_NOT_FOUND") {
 			return &apierr.APIError{
 				ErrorCode:  "NOT_FOUND",
 				StatusCode: 404,
@@ -134,8 +146,11 @@ func (ta *SqlPermissions) read() error {
 	ta.PrivilegeAssignments = []PrivilegeAssignment{}
 
 	// iterate over existing permissions over given data object
-	var currentPrincipal, currentAction, currentType, currentKey string
-	for currentGrantsOnThis.Scan(&currentPrincipal, &currentAction, &currentType, &currentKey) {
+	for _, row := range currentGrantsOnThis {
+		if len(row) < 4 {
+			continue
+		}
+		currentPrincipal, currentAction, currentType, currentKey := row[0], row[1], row[2], row[3]
 		if currentType == "CATALOG$" {
 			currentType = "CATALOG"
 			currentKey = ""
@@ -193,18 +208,19 @@ func (ta *SqlPermissions) read() error {
 	return nil
 }
 
-func (ta *SqlPermissions) revoke() error {
+func (ta *SqlPermissions) revoke(ctx context.Context) error {
 	existing, err := loadTableACL(ta.ID())
 	if err != nil {
 		return err
 	}
 	existing.exec = ta.exec
 	existing.ClusterID = ta.ClusterID
-	if err = existing.read(); err != nil {
+	existing.WarehouseID = ta.WarehouseID
+	if err = existing.read(ctx); err != nil {
 		return err
 	}
 	for _, privilegeAssignment := range existing.PrivilegeAssignments {
-		if err = ta.apply(func(objType, key string) string {
+		if err = ta.apply(ctx, func(objType, key string) string {
 			return fmt.Sprintf("REVOKE ALL PRIVILEGES ON %s %s FROM `%s`",
 				objType, key, privilegeAssignment.Principal)
 		}); err != nil {
@@ -214,12 +230,12 @@ func (ta *SqlPermissions) revoke() error {
 	return nil
 }
 
-func (ta *SqlPermissions) enforce() (err error) {
-	if err = ta.revoke(); err != nil {
+func (ta *SqlPermissions) enforce(ctx context.Context) (err error) {
+	if err = ta.revoke(ctx); err != nil {
 		return err
 	}
 	for _, privilegeAssignment := range ta.PrivilegeAssignments {
-		if err = ta.apply(func(objType, key string) string {
+		if err = ta.apply(ctx, func(objType, key string) string {
 			privileges := strings.Join(privilegeAssignment.Privileges, ", ")
 			return fmt.Sprintf("GRANT %s ON %s %s TO `%s`",
 				privileges, objType, key, privilegeAssignment.Principal)
@@ -230,21 +246,28 @@ func (ta *SqlPermissions) enforce() (err error) {
 	return nil
 }
 
-func (ta *SqlPermissions) apply(qb func(objType, key string) string) error {
+func (ta *SqlPermissions) apply(ctx context.Context, qb func(objType, key string) string) error {
 	objType, key := ta.typeAndKey()
 	if objType == "" && key == "" {
 		return fmt.Errorf("invalid ID")
 	}
 	sqlQuery := qb(objType, key)
 	log.Printf("[INFO] Executing SQL: %s", sqlQuery)
-	r := ta.exec.Execute(ta.ClusterID, "sql", sqlQuery)
-	if !r.Failed() {
-		return nil
-	}
-	return fmt.Errorf("cannot execute %s: %s", sqlQuery, r.Error())
+	_, err := ta.exec.Execute(ctx, sqlQuery)
+	return err
 }
 
 func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (err error) {
+	// if a warehouse id is specified, execute statements on the warehouse without starting any cluster
+	if wi, ok := d.GetOk("warehouse_id"); ok {
+		ta.WarehouseID = wi.(string)
+		w, err := c.WorkspaceClient()
+		if err != nil {
+			return err
+		}
+		ta.exec = c.WarehouseStatementExecutor(w, ta.WarehouseID, hiveMetastoreCatalog)
+		return nil
+	}
 	clustersAPI := clusters.NewClustersAPI(ctx, c)
 	if ci, ok := d.GetOk("cluster_id"); ok {
 		ta.ClusterID = ci.(string)
@@ -271,7 +294,7 @@ func (ta *SqlPermissions) initCluster(ctx context.Context, d *schema.ResourceDat
 			clusterInfo.ClusterName, clusterInfo.ClusterID)
 		return
 	}
-	ta.exec = c.CommandExecutor(ctx)
+	ta.exec = c.ClusterStatementExecutor(ctx, ta.ClusterID)
 	return nil
 }
 
@@ -335,6 +358,9 @@ func ResourceSqlPermissions() common.Resource {
 			return false
 		}
 		s["cluster_id"].Computed = true
+		s["cluster_id"].ConflictsWith = []string{"warehouse_id"}
+		// catalog, any file & anonymous function securables don't exist on warehouses with Unity Catalog
+		s["warehouse_id"].ConflictsWith = []string{"cluster_id", "catalog", "any_file", "anonymous_function"}
 		common.AddNamespaceInSchema(s)
 		common.NamespaceCustomizeSchemaMap(s)
 		return s
@@ -353,7 +379,7 @@ func ResourceSqlPermissions() common.Resource {
 			if err != nil {
 				return err
 			}
-			if err = ta.enforce(); err != nil {
+			if err = ta.enforce(ctx); err != nil {
 				return err
 			}
 			d.SetId(ta.ID())
@@ -364,11 +390,20 @@ func ResourceSqlPermissions() common.Resource {
 			if err != nil {
 				return err
 			}
+			if c.IsReadOnly() {
+				// grants are read by SQL statements on a warehouse or by commands on a cluster that is started
+				// if needed, and all of them are rejected in read-only mode, so the state is kept as it is
+				return &common.ReadSkippedError{
+					Reason: "Grants are read by executing SQL statements or commands, which isn't allowed when " +
+						"the provider is configured with read_only = true. Grants are kept as they are in the state, " +
+						"so changes made outside of Terraform aren't detected.",
+				}
+			}
 			ta, err := tableAclForLoad(ctx, d, c)
 			if err != nil {
 				return err
 			}
-			if err = ta.read(); err != nil {
+			if err = ta.read(ctx); err != nil {
 				return err
 			}
 			if len(ta.PrivilegeAssignments) == 0 {
@@ -387,10 +422,10 @@ func ResourceSqlPermissions() common.Resource {
 			if err != nil {
 				return err
 			}
-			if !d.HasChangesExcept("cluster_id") {
+			if !d.HasChangesExcept("cluster_id", "warehouse_id") {
 				return nil
 			}
-			return ta.enforce()
+			return ta.enforce(ctx)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			c, err := c.DatabricksClientForUnifiedProvider(ctx, d)
@@ -401,7 +436,7 @@ func ResourceSqlPermissions() common.Resource {
 			if err != nil {
 				return err
 			}
-			return ta.revoke()
+			return ta.revoke(ctx)
 		},
 	}
 }
@@ -1,9 +1,11 @@
 package access
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -45,31 +47,41 @@ func TestTableACLID_errors(t *testing.T) {
 
 type mockData map[string][][]string
 
-func (md mockData) Execute(clusterID, language, commandStr string) common.CommandResults {
-	data, ok := md[commandStr]
+func (md mockData) Execute(_ context.Context, statement string) ([][]string, error) {
+	data, ok := md[statement]
 	if !ok {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    fmt.Sprintf("Query is not mocked: %s", commandStr),
-		}
-	}
-	var x []any
-	for _, a := range data {
-		var y []any
-		for _, b := range a {
-			y = append(y, b)
-		}
-		x = append(x, y)
-	}
-	return common.CommandResults{
-		ResultType: "table",
-		Data:       x,
+		return nil, &common.StatementError{Statement: statement, Message: fmt.Sprintf("Query is not mocked: %s", statement)}
 	}
+	return data, nil
 }
 
 func (md mockData) toCommandMock() func(string) common.CommandResults {
 	return func(commandStr string) common.CommandResults {
-		return md.Execute("_", "cobol", commandStr)
+		data, ok := md[commandStr]
+		if !ok {
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    fmt.Sprintf("Query is not mocked: %s", commandStr),
+			}
+		}
+		var x []any
+		for _, a := range data {
+			var y []any
+			for _, b := range a {
+				y = append(y, b)
+			}
+			x = append(x, y)
+		}
+		return common.CommandResults{
+			ResultType: "table",
+			Data:       x,
+		}
+	}
+}
+
+func (md mockData) toStatementMock() common.StatementMock {
+	return func(statement string) ([][]string, error) {
+		return md.Execute(context.Background(), statement)
 	}
 }
 
@@ -84,7 +96,7 @@ func TestTableACLGrants(t *testing.T) {
 			{"interns", "DENIED_SELECT", "table", "`default`.`foo`"},
 		},
 	}}
-	err := ta.read()
+	err := ta.read(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, ta.PrivilegeAssignments, 1)
 	assert.Len(t, ta.PrivilegeAssignments[0].Privileges, 2)
@@ -100,7 +112,7 @@ func TestDatabaseACLGrants(t *testing.T) {
 				{"users", "USAGE", "database", "`default`"},
 			},
 		}}
-	err := ta.read()
+	err := ta.read(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, ta.PrivilegeAssignments, 1)
 	assert.Len(t, ta.PrivilegeAssignments[0].Privileges, 2)
@@ -108,28 +120,28 @@ func TestDatabaseACLGrants(t *testing.T) {
 
 type failedCommand string
 
-func (fc failedCommand) Execute(clusterID, language, commandStr string) common.CommandResults {
-	return common.CommandResults{
-		ResultType: "error",
-		Summary:    string(fc),
-	}
+func (fc failedCommand) Execute(_ context.Context, statement string) ([][]string, error) {
+	return nil, &common.StatementError{Statement: statement, Message: string(fc)}
 }
 
 func (fc failedCommand) toCommandMock() func(commandStr string) common.CommandResults {
 	return func(commandStr string) common.CommandResults {
-		return fc.Execute("..", "sql", commandStr)
+		return common.CommandResults{
+			ResultType: "error",
+			Summary:    string(fc),
+		}
 	}
 }
 
 func TestTableACL_NotFound(t *testing.T) {
 	ta := SqlPermissions{Table: "foo", exec: failedCommand("Table does not exist")}
-	err := ta.read()
+	err := ta.read(context.Background())
 	assert.EqualError(t, err, "Table does not exist")
 }
 
 func TestTableACL_OtherError(t *testing.T) {
 	ta := SqlPermissions{Table: "foo", exec: failedCommand("Some error")}
-	err := ta.read()
+	err := ta.read(context.Background())
 	assert.EqualError(t, err, "cannot read current grants: Some error")
 }
 
@@ -144,7 +156,7 @@ func TestTableACL_Revoke(t *testing.T) {
 		"REVOKE ALL PRIVILEGES ON TABLE `default`.`foo` FROM `users`":   {},
 		"REVOKE ALL PRIVILEGES ON TABLE `default`.`foo` FROM `interns`": {},
 	}}
-	err := ta.revoke()
+	err := ta.revoke(context.Background())
 	require.NoError(t, err)
 }
 
@@ -170,7 +182,7 @@ func TestTableACL_Enforce(t *testing.T) {
 			"GRANT SELECT ON TABLE `default`.`foo` TO `support`":                 {},
 		},
 	}
-	err := ta.enforce()
+	err := ta.enforce(context.Background())
 	require.NoError(t, err)
 }
 
@@ -385,6 +397,28 @@ func TestResourceSqlPermissions_Read_ErrorCommand(t *testing.T) {
 	}.ExpectError(t, "cannot read current grants: does not clusters")
 }
 
+func TestResourceSqlPermissions_Read_ReadOnly(t *testing.T) {
+	// no HTTP fixtures and mocks, so any attempt to start a cluster or to execute a statement fails
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{})
+	require.NoError(t, err)
+	defer server.Close()
+	client.SetReadOnly(true)
+
+	r := ResourceSqlPermissions()
+	d := r.ToResource().TestResourceData()
+	d.SetId("table/default.foo")
+	d.Set("table", "foo")
+	d.Set("warehouse_id", "abc")
+	d.Set("privilege_assignments", []any{
+		map[string]any{"principal": "users", "privileges": []any{"SELECT"}},
+	})
+	err = r.Read(context.Background(), d, client)
+	var skipped *common.ReadSkippedError
+	require.ErrorAs(t, err, &skipped)
+	assert.Equal(t, "table/default.foo", d.Id())
+	assert.Equal(t, 1, d.Get("privilege_assignments.#"))
+}
+
 func TestResourceSqlPermissions_Create(t *testing.T) {
 	qa.ResourceFixture{
 		CommandMock: mockData{
@@ -414,6 +448,68 @@ func TestResourceSqlPermissions_Create(t *testing.T) {
 	}.ApplyNoError(t)
 }
 
+func TestResourceSqlPermissions_Create_Warehouse(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		StatementMock: mockData{
+			"SHOW GRANT ON TABLE `default`.`foo`": {
+				{"users", "SELECT", "table", "`default`.`foo`"},
+			},
+			"REVOKE ALL PRIVILEGES ON TABLE `default`.`foo` FROM `users`":          {},
+			"GRANT MODIFY, SELECT ON TABLE `default`.`foo` TO `serge@example.com`": {},
+		}.toStatementMock(),
+		HCL: `
+		table = "foo"
+		warehouse_id = "abc"
+		privilege_assignments {
+			principal = "serge@example.com"
+			privileges = ["SELECT", "MODIFY"]
+		}
+		`,
+		Resource: ResourceSqlPermissions(),
+		Create:   true,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "table/default.foo", d.Id())
+	assert.Equal(t, "abc", d.Get("warehouse_id"))
+	assert.Equal(t, "", d.Get("cluster_id"))
+}
+
+func TestResourceSqlPermissions_Read_WarehouseNotFound(t *testing.T) {
+	qa.ResourceFixture{
+		StatementMock: func(statement string) ([][]string, error) {
+			return nil, &apierr.APIError{
+				ErrorCode:  "BAD_REQUEST",
+				StatusCode: 400,
+				Message: "cannot execute " + statement + ": [TABLE_OR_VIEW_NOT_FOUND] " +
+					"The table or view `hive_metastore`.`default`.`foo` cannot be found.",
+			}
+		},
+		State: map[string]any{
+			"table":        "foo",
+			"warehouse_id": "abc",
+		},
+		Resource: ResourceSqlPermissions(),
+		Read:     true,
+		Removed:  true,
+		ID:       "table/default.foo",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSqlPermissions_Create_WarehouseAnyFile(t *testing.T) {
+	qa.ResourceFixture{
+		HCL: `
+		any_file = true
+		warehouse_id = "abc"
+		privilege_assignments {
+			principal = "serge@example.com"
+			privileges = ["SELECT"]
+		}
+		`,
+		Resource: ResourceSqlPermissions(),
+		Create:   true,
+	}.ExpectError(t, "invalid config supplied. [warehouse_id] Conflicting configuration arguments")
+}
+
 func TestResourceSqlPermissions_Create_Catalog(t *testing.T) {
 	qa.ResourceFixture{
 		CommandMock: mockData{
@@ -17,14 +17,14 @@ type awsIamPolicy struct {
 }
 
 type awsIamPolicyStatement struct {
-	Sid          string                       `json:"Sid,omitempty"`
-	Effect       string                       `json:"Effect,omitempty"`
-	Actions      any                          `json:"Action,omitempty"`
-	NotActions   any                          `json:"NotAction,omitempty"`
-	Resources    any                          `json:"Resource,omitempty"`
-	NotResources any                          `json:"NotResource,omitempty"`
-	Principal    map[string]string            `json:"Principal,omitempty"`
-	Condition    map[string]map[string]string `json:"Condition,omitempty"`
+	Sid          string                    `json:"Sid,omitempty"`
+	Effect       string                    `json:"Effect,omitempty"`
+	Actions      any                       `json:"Action,omitempty"`
+	NotActions   any                       `json:"NotAction,omitempty"`
+	Resources    any                       `json:"Resource,omitempty"`
+	NotResources any                       `json:"NotResource,omitempty"`
+	Principal    map[string]string         `json:"Principal,omitempty"`
+	Condition    map[string]map[string]any `json:"Condition,omitempty"`
 }
 
 // DataAwsAssumeRolePolicy ...
@@ -46,7 +46,7 @@ func DataAwsAssumeRolePolicy() common.Resource {
 					{
 						Effect:  "Allow",
 						Actions: "sts:AssumeRole",
-						Condition: map[string]map[string]string{
+						Condition: map[string]map[string]any{
 							"StringEquals": {
 								"sts:ExternalId": externalID,
 							},
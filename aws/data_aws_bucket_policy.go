@@ -48,7 +48,7 @@ func DataAwsBucketPolicy() common.Resource {
 			}
 			e2AccountId := d.Get("databricks_e2_account_id").(string)
 			if e2AccountId != "" {
-				policy.Statements[0].Condition = map[string]map[string]string{
+				policy.Statements[0].Condition = map[string]map[string]any{
 					"StringEquals": {
 						"aws:PrincipalTag/DatabricksAccountId": e2AccountId,
 					},
@@ -153,7 +153,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						"iam:PutRolePolicy",
 					},
 					Resources: fmt.Sprintf("arn:%s:iam::*:role/aws-service-role/spot.amazonaws.com/AWSServiceRoleForEC2Spot", awsNamespace),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringLike": {
 							"iam:AWSServiceName": "spot.amazonaws.com",
 						},
@@ -187,7 +187,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						"ec2:ReplaceIamInstanceProfileAssociation",
 					},
 					Resources: fmt.Sprintf("arn:%s:ec2:%s:%s:instance/*", awsNamespace, region, aws_account_id),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:ResourceTag/Vendor": "Databricks",
 						},
@@ -201,7 +201,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						fmt.Sprintf("arn:%s:ec2:%s:%s:volume/*", awsNamespace, region, aws_account_id),
 						fmt.Sprintf("arn:%s:ec2:%s:%s:instance/*", awsNamespace, region, aws_account_id),
 					},
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"aws:RequestTag/Vendor": "Databricks",
 						},
@@ -212,7 +212,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 					Effect:    "Allow",
 					Actions:   "ec2:RunInstances",
 					Resources: fmt.Sprintf("arn:%s:ec2:%s:%s:image/*", awsNamespace, region, aws_account_id),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"aws:ResourceTag/Vendor": "Databricks",
 						},
@@ -227,7 +227,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						fmt.Sprintf("arn:%s:ec2:%s:%s:subnet/*", awsNamespace, region, aws_account_id),
 						fmt.Sprintf("arn:%s:ec2:%s:%s:security-group/*", awsNamespace, region, aws_account_id),
 					},
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:vpc": fmt.Sprintf("arn:%s:ec2:%s:%s:vpc/%s", awsNamespace, region, aws_account_id, vpc_id),
 						},
@@ -251,7 +251,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 					Effect:    "Allow",
 					Actions:   "ec2:TerminateInstances",
 					Resources: fmt.Sprintf("arn:%s:ec2:%s:%s:instance/*", awsNamespace, region, aws_account_id),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:ResourceTag/Vendor": "Databricks",
 						},
@@ -268,7 +268,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						fmt.Sprintf("arn:%s:ec2:%s:%s:instance/*", awsNamespace, region, aws_account_id),
 						fmt.Sprintf("arn:%s:ec2:%s:%s:volume/*", awsNamespace, region, aws_account_id),
 					},
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:ResourceTag/Vendor": "Databricks",
 						},
@@ -279,7 +279,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 					Effect:    "Allow",
 					Actions:   "ec2:CreateVolume",
 					Resources: fmt.Sprintf("arn:%s:ec2:%s:%s:volume/*", awsNamespace, region, aws_account_id),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"aws:RequestTag/Vendor": "Databricks",
 						},
@@ -292,7 +292,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 					Resources: []string{
 						fmt.Sprintf("arn:%s:ec2:%s:%s:volume/*", awsNamespace, region, aws_account_id),
 					},
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:ResourceTag/Vendor": "Databricks",
 						},
@@ -308,7 +308,7 @@ func DataAwsCrossaccountPolicy() common.Resource {
 						"ec2:RevokeSecurityGroupIngress",
 					},
 					Resources: fmt.Sprintf("arn:%s:ec2:%s:%s:security-group/%s", awsNamespace, region, aws_account_id, security_group_id),
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"ec2:vpc": fmt.Sprintf("arn:%s:ec2:%s:%s:vpc/%s", awsNamespace, region, aws_account_id, vpc_id),
 						},
@@ -36,7 +36,7 @@ func DataAwsUnityCatalogAssumeRolePolicy() common.Resource {
 					Sid:     "UnityCatalogAssumeRole",
 					Effect:  "Allow",
 					Actions: "sts:AssumeRole",
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"StringEquals": {
 							"sts:ExternalId": data.ExternalId,
 						},
@@ -49,7 +49,7 @@ func DataAwsUnityCatalogAssumeRolePolicy() common.Resource {
 					Sid:     "ExplicitSelfRoleAssumption",
 					Effect:  "Allow",
 					Actions: "sts:AssumeRole",
-					Condition: map[string]map[string]string{
+					Condition: map[string]map[string]any{
 						"ArnLike": {
 							"aws:PrincipalArn": fmt.Sprintf("arn:%s:iam::%s:role/%s", awsNamespace, data.AwsAccountId, data.RoleName),
 						},
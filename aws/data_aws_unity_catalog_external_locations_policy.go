@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// s3Location is the bucket and the prefix within it, that is covered by an external location
+type s3Location struct {
+	bucket string
+	prefix string
+}
+
+func parseS3Location(url string) (s3Location, error) {
+	var path string
+	var ok bool
+	for _, scheme := range []string{"s3://", "s3a://"} {
+		if path, ok = strings.CutPrefix(url, scheme); ok {
+			break
+		}
+	}
+	if !ok {
+		return s3Location{}, fmt.Errorf("%s is not an S3 URL", url)
+	}
+	bucket, prefix, _ := strings.Cut(path, "/")
+	if !AwsBucketNameRegex.MatchString(bucket) {
+		return s3Location{}, fmt.Errorf("bucket name of %s %s", url, AwsBucketNameRegexError)
+	}
+	return s3Location{bucket: bucket, prefix: strings.Trim(prefix, "/")}, nil
+}
+
+// minimalPrefixes returns prefixes of every bucket without prefixes nested in other prefixes. Empty prefix
+// means the whole bucket.
+func minimalPrefixes(locations []s3Location) map[string][]string {
+	byBucket := map[string][]string{}
+	for _, l := range locations {
+		byBucket[l.bucket] = append(byBucket[l.bucket], l.prefix)
+	}
+	for bucket, prefixes := range byBucket {
+		// shorter prefixes are sorted before prefixes nested in them
+		sort.Strings(prefixes)
+		minimal := []string{}
+		for _, prefix := range prefixes {
+			covered := false
+			for _, parent := range minimal {
+				if parent == "" || prefix == parent || strings.HasPrefix(prefix, parent+"/") {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				minimal = append(minimal, prefix)
+			}
+		}
+		byBucket[bucket] = minimal
+	}
+	return byBucket
+}
+
+// parseIamRoleArn returns partition and account ID of the IAM role
+func parseIamRoleArn(roleArn string) (string, string, error) {
+	parts := strings.SplitN(roleArn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "iam" || !strings.HasPrefix(parts[5], "role/") {
+		return "", "", fmt.Errorf("%s is not an IAM role ARN", roleArn)
+	}
+	return parts[1], parts[4], nil
+}
+
+func kmsKeyArn(location catalog.ExternalLocationInfo) string {
+	if location.EncryptionDetails == nil || location.EncryptionDetails.SseEncryptionDetails == nil {
+		return ""
+	}
+	sse := location.EncryptionDetails.SseEncryptionDetails
+	if sse.Algorithm != catalog.SseEncryptionDetailsAlgorithmAwsSseKms {
+		return ""
+	}
+	return sse.AwsKmsKeyArn
+}
+
+// unityCatalogLocationsPolicy returns the IAM policy of the storage credential role, that allows access
+// only to the given external locations
+func unityCatalogLocationsPolicy(roleArn string, locations []catalog.ExternalLocationInfo) (*awsIamPolicy, error) {
+	awsNamespace, awsAccountId, err := parseIamRoleArn(roleArn)
+	if err != nil {
+		return nil, err
+	}
+	var s3Locations []s3Location
+	kmsKeys := map[string]bool{}
+	for _, location := range locations {
+		l, err := parseS3Location(location.Url)
+		if err != nil {
+			return nil, fmt.Errorf("external location %s: %w", location.Name, err)
+		}
+		s3Locations = append(s3Locations, l)
+		if key := kmsKeyArn(location); key != "" {
+			kmsKeys[key] = true
+		}
+	}
+	byBucket := minimalPrefixes(s3Locations)
+	buckets := make([]string, 0, len(byBucket))
+	for bucket := range byBucket {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+
+	var objectArns, bucketArns, wholeBucketArns []string
+	var prefixListStatements []*awsIamPolicyStatement
+	for _, bucket := range buckets {
+		bucketArn := fmt.Sprintf("arn:%s:s3:::%s", awsNamespace, bucket)
+		bucketArns = append(bucketArns, bucketArn)
+		for _, prefix := range byBucket[bucket] {
+			if prefix == "" {
+				objectArns = append(objectArns, bucketArn+"/*")
+				wholeBucketArns = append(wholeBucketArns, bucketArn)
+				continue
+			}
+			objectArns = append(objectArns, fmt.Sprintf("%s/%s/*", bucketArn, prefix))
+			prefixListStatements = append(prefixListStatements, &awsIamPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:ListBucket"},
+				Resources: []string{bucketArn},
+				Condition: map[string]map[string]any{
+					"StringLike": {
+						"s3:prefix": []string{prefix, prefix + "/*"},
+					},
+				},
+			})
+		}
+	}
+
+	policy := &awsIamPolicy{Version: "2012-10-17"}
+	if len(buckets) > 0 {
+		policy.Statements = append(policy.Statements,
+			&awsIamPolicyStatement{
+				Effect: "Allow",
+				Actions: []string{
+					"s3:GetObject",
+					"s3:PutObject",
+					"s3:DeleteObject",
+					// Multipart uploads support
+					"s3:ListMultipartUploadParts",
+					"s3:AbortMultipartUpload",
+				},
+				Resources: objectArns,
+			},
+			&awsIamPolicyStatement{
+				Effect: "Allow",
+				Actions: []string{
+					"s3:GetBucketLocation",
+					"s3:ListBucketMultipartUploads",
+				},
+				Resources: bucketArns,
+			})
+	}
+	if len(wholeBucketArns) > 0 {
+		policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
+			Effect:    "Allow",
+			Actions:   []string{"s3:ListBucket"},
+			Resources: wholeBucketArns,
+		})
+	}
+	policy.Statements = append(policy.Statements, prefixListStatements...)
+	policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
+		Effect:    "Allow",
+		Actions:   []string{"sts:AssumeRole"},
+		Resources: []string{roleArn},
+	})
+	if len(kmsKeys) > 0 {
+		keys := make([]string, 0, len(kmsKeys))
+		for key := range kmsKeys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
+			Effect: "Allow",
+			Actions: []string{
+				"kms:Decrypt",
+				"kms:Encrypt",
+				"kms:GenerateDataKey*",
+			},
+			Resources: keys,
+		})
+	}
+	if len(buckets) > 0 {
+		policy.Statements = append(policy.Statements,
+			managedFileEventsStatements(awsNamespace, awsAccountId, bucketArns)...)
+	}
+	return policy, nil
+}
+
+// DataAwsUnityCatalogExternalLocationsPolicy generates IAM policies of storage credentials, that allow access
+// only to URLs of the given external locations, or of all external locations using the given storage credentials
+func DataAwsUnityCatalogExternalLocationsPolicy() common.Resource {
+	type credentialPolicy struct {
+		StorageCredential string   `json:"storage_credential"`
+		RoleArn           string   `json:"role_arn"`
+		ExternalLocations []string `json:"external_locations,omitempty"`
+		JSON              string   `json:"json"`
+	}
+	type externalLocationsPolicy struct {
+		common.Namespace
+		ExternalLocations  []string           `json:"external_locations,omitempty"`
+		StorageCredentials []string           `json:"storage_credentials,omitempty"`
+		Policies           []credentialPolicy `json:"policies,omitempty" tf:"computed"`
+		Id                 string             `json:"id,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *externalLocationsPolicy, w *databricks.WorkspaceClient) error {
+		if len(data.ExternalLocations) == 0 && len(data.StorageCredentials) == 0 {
+			return fmt.Errorf("at least one of external_locations or storage_credentials must be specified")
+		}
+		locationsByCredential := map[string][]catalog.ExternalLocationInfo{}
+		seen := map[string]bool{}
+		addLocation := func(location catalog.ExternalLocationInfo) {
+			if seen[location.Name] {
+				return
+			}
+			seen[location.Name] = true
+			locationsByCredential[location.CredentialName] = append(
+				locationsByCredential[location.CredentialName], location)
+		}
+		for _, name := range data.ExternalLocations {
+			location, err := w.ExternalLocations.GetByName(ctx, name)
+			if err != nil {
+				return err
+			}
+			addLocation(*location)
+		}
+		if len(data.StorageCredentials) > 0 {
+			credentials := map[string]bool{}
+			for _, name := range data.StorageCredentials {
+				credentials[name] = true
+				if _, ok := locationsByCredential[name]; !ok {
+					locationsByCredential[name] = nil
+				}
+			}
+			locations, err := w.ExternalLocations.ListAll(ctx, catalog.ListExternalLocationsRequest{})
+			if err != nil {
+				return err
+			}
+			for _, location := range locations {
+				if credentials[location.CredentialName] {
+					addLocation(location)
+				}
+			}
+		}
+		credentialNames := make([]string, 0, len(locationsByCredential))
+		for name := range locationsByCredential {
+			credentialNames = append(credentialNames, name)
+		}
+		sort.Strings(credentialNames)
+		data.Policies = []credentialPolicy{}
+		for _, name := range credentialNames {
+			credential, err := w.StorageCredentials.GetByName(ctx, name)
+			if err != nil {
+				return err
+			}
+			if credential.AwsIamRole == nil {
+				return fmt.Errorf("storage credential %s doesn't use an AWS IAM role", name)
+			}
+			locations := locationsByCredential[name]
+			sort.Slice(locations, func(i, j int) bool {
+				return locations[i].Name < locations[j].Name
+			})
+			policy, err := unityCatalogLocationsPolicy(credential.AwsIamRole.RoleArn, locations)
+			if err != nil {
+				return fmt.Errorf("storage credential %s: %w", name, err)
+			}
+			policyJSON, err := json.MarshalIndent(policy, "", "  ")
+			if err != nil {
+				return err
+			}
+			locationNames := []string{}
+			for _, location := range locations {
+				locationNames = append(locationNames, location.Name)
+			}
+			data.Policies = append(data.Policies, credentialPolicy{
+				StorageCredential: name,
+				RoleArn:           credential.AwsIamRole.RoleArn,
+				ExternalLocations: locationNames,
+				JSON:              string(policyJSON),
+			})
+		}
+		data.Id = strings.Join(credentialNames, ",")
+		return nil
+	})
+}
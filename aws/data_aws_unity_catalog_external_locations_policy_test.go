@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMinimalPrefixes(t *testing.T) {
+	assert.Equal(t, map[string][]string{
+		"a": {""},
+		"b": {"x", "y/z"},
+	}, minimalPrefixes([]s3Location{
+		{bucket: "a", prefix: "foo"},
+		{bucket: "a", prefix: ""},
+		{bucket: "b", prefix: "x/1"},
+		{bucket: "b", prefix: "x"},
+		{bucket: "b", prefix: "y/z"},
+		{bucket: "b", prefix: "x/y"},
+	}))
+}
+
+func TestParseS3Location(t *testing.T) {
+	l, err := parseS3Location("s3://bucket/some/prefix/")
+	assert.NoError(t, err)
+	assert.Equal(t, s3Location{bucket: "bucket", prefix: "some/prefix"}, l)
+	l, err = parseS3Location("s3a://bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, s3Location{bucket: "bucket"}, l)
+	_, err = parseS3Location("abfss://container@account.dfs.core.windows.net/")
+	assert.EqualError(t, err, "abfss://container@account.dfs.core.windows.net/ is not an S3 URL")
+}
+
+func TestDataAwsUnityCatalogExternalLocationsPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockExternalLocationsAPI().EXPECT()
+			e.GetByName(mock.Anything, "raw").Return(&catalog.ExternalLocationInfo{
+				Name:           "raw",
+				Url:            "s3://landing/raw",
+				CredentialName: "landing",
+			}, nil)
+			e.ListAll(mock.Anything, catalog.ListExternalLocationsRequest{}).Return([]catalog.ExternalLocationInfo{
+				{
+					Name:           "curated",
+					Url:            "s3://lake/curated/",
+					CredentialName: "lake",
+					EncryptionDetails: &catalog.EncryptionDetails{
+						SseEncryptionDetails: &catalog.SseEncryptionDetails{
+							Algorithm:    catalog.SseEncryptionDetailsAlgorithmAwsSseKms,
+							AwsKmsKeyArn: "arn:aws:kms:us-east-1:123456789012:key/lake",
+						},
+					},
+				},
+				{
+					Name:           "other",
+					Url:            "s3://other",
+					CredentialName: "other",
+				},
+			}, nil)
+			s := w.GetMockStorageCredentialsAPI().EXPECT()
+			s.GetByName(mock.Anything, "landing").Return(&catalog.StorageCredentialInfo{
+				Name: "landing",
+				AwsIamRole: &catalog.AwsIamRoleResponse{
+					RoleArn: "arn:aws:iam::123456789012:role/landing",
+				},
+			}, nil)
+			s.GetByName(mock.Anything, "lake").Return(&catalog.StorageCredentialInfo{
+				Name: "lake",
+				AwsIamRole: &catalog.AwsIamRoleResponse{
+					RoleArn: "arn:aws:iam::123456789012:role/lake",
+				},
+			}, nil)
+		},
+		Resource:    DataAwsUnityCatalogExternalLocationsPolicy(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		external_locations = ["raw"]
+		storage_credentials = ["lake"]
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "lake,landing", d.Id())
+	assert.Equal(t, 2, d.Get("policies.#"))
+	assert.Equal(t, "lake", d.Get("policies.0.storage_credential"))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/lake", d.Get("policies.0.role_arn"))
+	assert.Equal(t, "curated", d.Get("policies.0.external_locations.0"))
+	assert.Equal(t, "landing", d.Get("policies.1.storage_credential"))
+
+	var policy map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("policies.0.json").(string)), &policy))
+	statements := policy["Statement"].([]any)
+	assert.Equal(t, map[string]any{
+		"Effect": "Allow",
+		"Action": []any{
+			"s3:GetObject",
+			"s3:PutObject",
+			"s3:DeleteObject",
+			"s3:ListMultipartUploadParts",
+			"s3:AbortMultipartUpload",
+		},
+		"Resource": []any{"arn:aws:s3:::lake/curated/*"},
+	}, statements[0])
+	assert.Equal(t, map[string]any{
+		"Effect":   "Allow",
+		"Action":   []any{"s3:ListBucket"},
+		"Resource": []any{"arn:aws:s3:::lake"},
+		"Condition": map[string]any{
+			"StringLike": map[string]any{
+				"s3:prefix": []any{"curated", "curated/*"},
+			},
+		},
+	}, statements[2])
+	assert.Equal(t, map[string]any{
+		"Effect":   "Allow",
+		"Action":   []any{"sts:AssumeRole"},
+		"Resource": []any{"arn:aws:iam::123456789012:role/lake"},
+	}, statements[3])
+	assert.Equal(t, map[string]any{
+		"Effect":   "Allow",
+		"Action":   []any{"kms:Decrypt", "kms:Encrypt", "kms:GenerateDataKey*"},
+		"Resource": []any{"arn:aws:kms:us-east-1:123456789012:key/lake"},
+	}, statements[4])
+	assert.Equal(t, "ManagedFileEventsSetupStatement", statements[5].(map[string]any)["Sid"])
+	assert.Contains(t, statements[5].(map[string]any)["Resource"], "arn:aws:s3:::lake")
+	assert.Len(t, statements, 8)
+}
+
+func TestDataAwsUnityCatalogExternalLocationsPolicy_WholeBucket(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			e := w.GetMockExternalLocationsAPI().EXPECT()
+			e.GetByName(mock.Anything, "root").Return(&catalog.ExternalLocationInfo{
+				Name:           "root",
+				Url:            "s3://gov-bucket/",
+				CredentialName: "gov",
+			}, nil)
+			w.GetMockStorageCredentialsAPI().EXPECT().GetByName(mock.Anything, "gov").Return(
+				&catalog.StorageCredentialInfo{
+					Name: "gov",
+					AwsIamRole: &catalog.AwsIamRoleResponse{
+						RoleArn: "arn:aws-us-gov:iam::123456789012:role/gov",
+					},
+				}, nil)
+		},
+		Resource:    DataAwsUnityCatalogExternalLocationsPolicy(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `external_locations = ["root"]`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	var policy awsIamPolicy
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("policies.0.json").(string)), &policy))
+	assert.Equal(t, []any{"arn:aws-us-gov:s3:::gov-bucket/*"}, policy.Statements[0].Resources)
+	assert.Equal(t, []any{"s3:ListBucket"}, policy.Statements[2].Actions)
+	assert.Equal(t, []any{"arn:aws-us-gov:s3:::gov-bucket"}, policy.Statements[2].Resources)
+	assert.Nil(t, policy.Statements[2].Condition)
+	assert.Equal(t, []any{"arn:aws-us-gov:sqs:*:123456789012:csms-*", "arn:aws-us-gov:sns:*:123456789012:csms-*"},
+		policy.Statements[5].Resources)
+}
+
+func TestDataAwsUnityCatalogExternalLocationsPolicy_NotAws(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockExternalLocationsAPI().EXPECT().GetByName(mock.Anything, "adls").Return(
+				&catalog.ExternalLocationInfo{
+					Name:           "adls",
+					Url:            "abfss://container@account.dfs.core.windows.net/",
+					CredentialName: "azure",
+				}, nil)
+			w.GetMockStorageCredentialsAPI().EXPECT().GetByName(mock.Anything, "azure").Return(
+				&catalog.StorageCredentialInfo{
+					Name:                 "azure",
+					AzureManagedIdentity: &catalog.AzureManagedIdentityResponse{},
+				}, nil)
+		},
+		Resource:    DataAwsUnityCatalogExternalLocationsPolicy(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `external_locations = ["adls"]`,
+	}.ExpectError(t, "storage credential azure doesn't use an AWS IAM role")
+}
+
+func TestDataAwsUnityCatalogExternalLocationsPolicy_NoInputs(t *testing.T) {
+	qa.ResourceFixture{
+		Resource:    DataAwsUnityCatalogExternalLocationsPolicy(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         ``,
+	}.ExpectError(t, "at least one of external_locations or storage_credentials must be specified")
+}
+
+func TestDataAwsUnityCatalogExternalLocationsPolicy_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataAwsUnityCatalogExternalLocationsPolicy(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL:         `storage_credentials = ["a"]`,
+	}.ExpectError(t, "i'm a teapot")
+}
@@ -64,37 +64,53 @@ func generateReadContext(ctx context.Context, d *schema.ResourceData, m *common.
 			Resources: []string{kmsArn},
 		})
 	}
-	policy.Statements = append(policy.Statements, &awsIamPolicyStatement{
-		Sid:    "ManagedFileEventsSetupStatement",
-		Effect: "Allow",
-		Actions: []string{
-			"s3:GetBucketNotification",
-			"s3:PutBucketNotification",
-			"sns:ListSubscriptionsByTopic",
-			"sns:GetTopicAttributes",
-			"sns:SetTopicAttributes",
-			"sns:CreateTopic",
-			"sns:TagResource",
-			"sns:Publish",
-			"sns:Subscribe",
-			"sqs:CreateQueue",
-			"sqs:DeleteMessage",
-			"sqs:ReceiveMessage",
-			"sqs:SendMessage",
-			"sqs:GetQueueUrl",
-			"sqs:GetQueueAttributes",
-			"sqs:SetQueueAttributes",
-			"sqs:TagQueue",
-			"sqs:ChangeMessageVisibility",
-			"sqs:PurgeQueue",
-		},
-		Resources: []string{
-			fmt.Sprintf("arn:%s:s3:::%s", awsNamespace, bucket),
-			fmt.Sprintf("arn:%s:sqs:*:%s:csms-*", awsNamespace, awsAccountId),
-			fmt.Sprintf("arn:%s:sns:*:%s:csms-*", awsNamespace, awsAccountId),
+	policy.Statements = append(policy.Statements, managedFileEventsStatements(awsNamespace, awsAccountId,
+		[]string{fmt.Sprintf("arn:%s:s3:::%s", awsNamespace, bucket)})...)
+	policyJSON, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s-%s-%s", bucket, awsAccountId, roleName))
+	err = d.Set("json", string(policyJSON))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// managedFileEventsStatements returns statements, that allow Unity Catalog to set up notifications of given buckets
+// and SNS topics with SQS queues for managed file events
+func managedFileEventsStatements(awsNamespace, awsAccountId string, bucketArns []string) []*awsIamPolicyStatement {
+	queues := fmt.Sprintf("arn:%s:sqs:*:%s:csms-*", awsNamespace, awsAccountId)
+	topics := fmt.Sprintf("arn:%s:sns:*:%s:csms-*", awsNamespace, awsAccountId)
+	return []*awsIamPolicyStatement{
+		{
+			Sid:    "ManagedFileEventsSetupStatement",
+			Effect: "Allow",
+			Actions: []string{
+				"s3:GetBucketNotification",
+				"s3:PutBucketNotification",
+				"sns:ListSubscriptionsByTopic",
+				"sns:GetTopicAttributes",
+				"sns:SetTopicAttributes",
+				"sns:CreateTopic",
+				"sns:TagResource",
+				"sns:Publish",
+				"sns:Subscribe",
+				"sqs:CreateQueue",
+				"sqs:DeleteMessage",
+				"sqs:ReceiveMessage",
+				"sqs:SendMessage",
+				"sqs:GetQueueUrl",
+				"sqs:GetQueueAttributes",
+				"sqs:SetQueueAttributes",
+				"sqs:TagQueue",
+				"sqs:ChangeMessageVisibility",
+				"sqs:PurgeQueue",
+			},
+			Resources: append(append([]string{}, bucketArns...), queues, topics),
 		},
-	},
-		&awsIamPolicyStatement{
+		{
 			Sid:    "ManagedFileEventsListStatement",
 			Effect: "Allow",
 			Actions: []string{
@@ -102,12 +118,9 @@ func generateReadContext(ctx context.Context, d *schema.ResourceData, m *common.
 				"sqs:ListQueueTags",
 				"sns:ListTopics",
 			},
-			Resources: []string{
-				fmt.Sprintf("arn:%s:sqs:*:%s:csms-*", awsNamespace, awsAccountId),
-				fmt.Sprintf("arn:%s:sns:*:%s:csms-*", awsNamespace, awsAccountId),
-			},
+			Resources: []string{queues, topics},
 		},
-		&awsIamPolicyStatement{
+		{
 			Sid:    "ManagedFileEventsTeardownStatement",
 			Effect: "Allow",
 			Actions: []string{
@@ -115,21 +128,9 @@ func generateReadContext(ctx context.Context, d *schema.ResourceData, m *common.
 				"sns:DeleteTopic",
 				"sqs:DeleteQueue",
 			},
-			Resources: []string{
-				fmt.Sprintf("arn:%s:sqs:*:%s:csms-*", awsNamespace, awsAccountId),
-				fmt.Sprintf("arn:%s:sns:*:%s:csms-*", awsNamespace, awsAccountId),
-			},
-		})
-	policyJSON, err := json.MarshalIndent(policy, "", "  ")
-	if err != nil {
-		return err
-	}
-	d.SetId(fmt.Sprintf("%s-%s-%s", bucket, awsAccountId, roleName))
-	err = d.Set("json", string(policyJSON))
-	if err != nil {
-		return err
+			Resources: []string{queues, topics},
+		},
 	}
-	return nil
 }
 
 func validateSchema() map[string]*schema.Schema {
@@ -0,0 +1,19 @@
+package azure
+
+import "regexp"
+
+// AzureDatabricksApplicationId is the application ID of the AzureDatabricks first-party application, that manages
+// resources of VNet-injected workspaces in all Azure tenants
+const AzureDatabricksApplicationId = "2ff814a6-3304-4ab8-85cb-cd0e6f879c1d"
+
+var AzureSubscriptionIdRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-([0-9a-fA-F]{4}-){3}[0-9a-fA-F]{12}$`)
+var AzureSubscriptionIdRegexError = "subscription_id must be a GUID"
+
+var AzureResourceGroupNameRegex = regexp.MustCompile(`^[-\w\.\(\)]{0,89}[-\w\(\)]$`)
+var AzureResourceGroupNameRegexError = "resource_group must contain only alphanumeric, underscore, parentheses, hyphen and period characters, and must not end with a period"
+
+var AzureStorageAccountNameRegex = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+var AzureStorageAccountNameRegexError = "storage_account_name must contain between 3 and 24 lowercase letters and digits"
+
+var AzureNetworkResourceNameRegex = regexp.MustCompile(`^[0-9a-zA-Z][-\w\.]{0,78}\w$`)
+var AzureNetworkResourceNameRegexError = "must begin with alphanumeric character, end with alphanumeric or underscore character, and contain only alphanumeric, underscore, period and hyphen characters"
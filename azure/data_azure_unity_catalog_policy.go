@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataAzureUnityCatalogPolicy defines the custom role and role assignments of the access connector,
+// that is used by Unity Catalog to access the storage account
+func DataAzureUnityCatalogPolicy() common.Resource {
+	type AzureUcPolicy struct {
+		SubscriptionId     string                `json:"subscription_id"`
+		ResourceGroup      string                `json:"resource_group"`
+		StorageAccountName string                `json:"storage_account_name"`
+		RoleName           string                `json:"role_name,omitempty" tf:"computed"`
+		JSON               string                `json:"json" tf:"computed"`
+		RoleAssignments    []AzureRoleAssignment `json:"role_assignments" tf:"computed"`
+		Id                 string                `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *AzureUcPolicy) error {
+		if err := validateSubscriptionAndResourceGroup(data.SubscriptionId, data.ResourceGroup); err != nil {
+			return err
+		}
+		if !AzureStorageAccountNameRegex.MatchString(data.StorageAccountName) {
+			return errors.New(AzureStorageAccountNameRegexError)
+		}
+		if data.RoleName == "" {
+			data.RoleName = fmt.Sprintf("Databricks Unity Catalog %s", data.StorageAccountName)
+		}
+		resourceGroup := resourceGroupId(data.SubscriptionId, data.ResourceGroup)
+		storageAccount := fmt.Sprintf("%s/providers/Microsoft.Storage/storageAccounts/%s",
+			resourceGroup, data.StorageAccountName)
+		role := azureRoleDefinition{
+			Name:        data.RoleName,
+			IsCustom:    true,
+			Description: fmt.Sprintf("Unity Catalog access to the %s storage account", data.StorageAccountName),
+			Actions: []string{
+				"Microsoft.Storage/storageAccounts/read",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/read",
+				"Microsoft.Storage/storageAccounts/blobServices/generateUserDelegationKey/action",
+				// managed file events
+				"Microsoft.Storage/storageAccounts/queueServices/read",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/read",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/write",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/delete",
+				"Microsoft.EventGrid/eventSubscriptions/read",
+				"Microsoft.EventGrid/eventSubscriptions/write",
+				"Microsoft.EventGrid/eventSubscriptions/delete",
+			},
+			DataActions: []string{
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/add/action",
+				"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/move/action",
+				// managed file events
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/read",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/write",
+				"Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete",
+			},
+			AssignableScopes: []string{storageAccount},
+		}
+		roleJSON, err := role.json()
+		if err != nil {
+			return err
+		}
+		data.JSON = roleJSON
+		data.RoleAssignments = []AzureRoleAssignment{
+			{
+				RoleDefinitionName: data.RoleName,
+				Scope:              storageAccount,
+			},
+			{
+				// storage events are delivered by Event Grid system topics, that are created in the resource group
+				RoleDefinitionName: "EventGrid EventSubscription Contributor",
+				Scope:              resourceGroup,
+			},
+		}
+		data.Id = storageAccount
+		return nil
+	})
+}
@@ -0,0 +1,80 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataAzureUnityCatalogPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "rg"
+		storage_account_name = "ucstorage"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	scope := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/ucstorage"
+	assert.Equal(t, scope, d.Id())
+	assert.Equal(t, "Databricks Unity Catalog ucstorage", d.Get("role_name"))
+	var role azureRoleDefinition
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &role))
+	assert.Equal(t, "Databricks Unity Catalog ucstorage", role.Name)
+	assert.True(t, role.IsCustom)
+	assert.Equal(t, []string{scope}, role.AssignableScopes)
+	assert.Contains(t, role.DataActions, "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read")
+	assert.Equal(t, []string{}, role.NotActions)
+
+	assert.Equal(t, 2, d.Get("role_assignments.#"))
+	assert.Equal(t, "Databricks Unity Catalog ucstorage", d.Get("role_assignments.0.role_definition_name"))
+	assert.Equal(t, scope, d.Get("role_assignments.0.scope"))
+	assert.Equal(t, "EventGrid EventSubscription Contributor", d.Get("role_assignments.1.role_definition_name"))
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg",
+		d.Get("role_assignments.1.scope"))
+}
+
+func TestDataAzureUnityCatalogPolicy_CustomRoleName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "rg"
+		storage_account_name = "ucstorage"
+		role_name = "uc-role"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "uc-role", d.Get("role_assignments.0.role_definition_name"))
+}
+
+func TestDataAzureUnityCatalogPolicy_Invalid(t *testing.T) {
+	for hcl, expected := range map[string]string{
+		`subscription_id = "abc"
+		resource_group = "rg"
+		storage_account_name = "ucstorage"`: AzureSubscriptionIdRegexError,
+		`subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "rg."
+		storage_account_name = "ucstorage"`: AzureResourceGroupNameRegexError,
+		`subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "rg"
+		storage_account_name = "UC-Storage"`: AzureStorageAccountNameRegexError,
+	} {
+		qa.ResourceFixture{
+			Read:        true,
+			Resource:    DataAzureUnityCatalogPolicy(),
+			NonWritable: true,
+			ID:          ".",
+			HCL:         hcl,
+		}.ExpectError(t, expected)
+	}
+}
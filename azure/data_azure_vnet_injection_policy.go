@@ -0,0 +1,84 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataAzureVnetInjectionPolicy defines the custom role and role assignments of the AzureDatabricks application,
+// that manages subnets and network security group rules of VNet-injected workspaces
+func DataAzureVnetInjectionPolicy() common.Resource {
+	type AzureVnetInjectionPolicy struct {
+		SubscriptionId           string                `json:"subscription_id"`
+		ResourceGroup            string                `json:"resource_group"`
+		VnetName                 string                `json:"vnet_name"`
+		NetworkSecurityGroupName string                `json:"network_security_group_name,omitempty"`
+		RoleName                 string                `json:"role_name,omitempty" tf:"computed"`
+		PrincipalApplicationId   string                `json:"principal_application_id" tf:"computed"`
+		JSON                     string                `json:"json" tf:"computed"`
+		RoleAssignments          []AzureRoleAssignment `json:"role_assignments" tf:"computed"`
+		Id                       string                `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *AzureVnetInjectionPolicy) error {
+		if err := validateSubscriptionAndResourceGroup(data.SubscriptionId, data.ResourceGroup); err != nil {
+			return err
+		}
+		if !AzureNetworkResourceNameRegex.MatchString(data.VnetName) {
+			return fmt.Errorf("vnet_name %s", AzureNetworkResourceNameRegexError)
+		}
+		if data.NetworkSecurityGroupName != "" && !AzureNetworkResourceNameRegex.MatchString(data.NetworkSecurityGroupName) {
+			return fmt.Errorf("network_security_group_name %s", AzureNetworkResourceNameRegexError)
+		}
+		if data.RoleName == "" {
+			data.RoleName = fmt.Sprintf("Databricks VNet injection %s", data.VnetName)
+		}
+		resourceGroup := resourceGroupId(data.SubscriptionId, data.ResourceGroup)
+		vnet := fmt.Sprintf("%s/providers/Microsoft.Network/virtualNetworks/%s", resourceGroup, data.VnetName)
+		role := azureRoleDefinition{
+			Name:        data.RoleName,
+			IsCustom:    true,
+			Description: fmt.Sprintf("Azure Databricks access to subnets of the %s virtual network", data.VnetName),
+			Actions: []string{
+				"Microsoft.Network/virtualNetworks/read",
+				"Microsoft.Network/virtualNetworks/subnets/read",
+				"Microsoft.Network/virtualNetworks/subnets/write",
+				"Microsoft.Network/virtualNetworks/subnets/join/action",
+				"Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action",
+				"Microsoft.Network/virtualNetworks/subnets/unprepareNetworkPolicies/action",
+			},
+			AssignableScopes: []string{vnet},
+		}
+		data.RoleAssignments = []AzureRoleAssignment{
+			{
+				RoleDefinitionName: data.RoleName,
+				Scope:              vnet,
+			},
+		}
+		if data.NetworkSecurityGroupName != "" {
+			nsg := fmt.Sprintf("%s/providers/Microsoft.Network/networkSecurityGroups/%s",
+				resourceGroup, data.NetworkSecurityGroupName)
+			role.Actions = append(role.Actions,
+				"Microsoft.Network/networkSecurityGroups/read",
+				"Microsoft.Network/networkSecurityGroups/join/action",
+				"Microsoft.Network/networkSecurityGroups/securityRules/read",
+				"Microsoft.Network/networkSecurityGroups/securityRules/write",
+				"Microsoft.Network/networkSecurityGroups/securityRules/delete",
+			)
+			role.AssignableScopes = append(role.AssignableScopes, nsg)
+			data.RoleAssignments = append(data.RoleAssignments, AzureRoleAssignment{
+				RoleDefinitionName: data.RoleName,
+				Scope:              nsg,
+			})
+		}
+		roleJSON, err := role.json()
+		if err != nil {
+			return err
+		}
+		data.JSON = roleJSON
+		data.PrincipalApplicationId = AzureDatabricksApplicationId
+		data.Id = vnet
+		return nil
+	})
+}
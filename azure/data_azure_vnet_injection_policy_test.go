@@ -0,0 +1,71 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataAzureVnetInjectionPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureVnetInjectionPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "network"
+		vnet_name = "workspace-vnet"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	vnet := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/virtualNetworks/workspace-vnet"
+	assert.Equal(t, vnet, d.Id())
+	assert.Equal(t, AzureDatabricksApplicationId, d.Get("principal_application_id"))
+	var role azureRoleDefinition
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &role))
+	assert.Equal(t, "Databricks VNet injection workspace-vnet", role.Name)
+	assert.Equal(t, []string{vnet}, role.AssignableScopes)
+	assert.Len(t, role.Actions, 6)
+	assert.Equal(t, 1, d.Get("role_assignments.#"))
+	assert.Equal(t, vnet, d.Get("role_assignments.0.scope"))
+}
+
+func TestDataAzureVnetInjectionPolicy_NetworkSecurityGroup(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureVnetInjectionPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "network"
+		vnet_name = "workspace-vnet"
+		network_security_group_name = "workspace-nsg"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	nsg := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/workspace-nsg"
+	var role azureRoleDefinition
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &role))
+	assert.Contains(t, role.AssignableScopes, nsg)
+	assert.Contains(t, role.Actions, "Microsoft.Network/networkSecurityGroups/securityRules/write")
+	assert.Equal(t, 2, d.Get("role_assignments.#"))
+	assert.Equal(t, nsg, d.Get("role_assignments.1.scope"))
+}
+
+func TestDataAzureVnetInjectionPolicy_Invalid(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataAzureVnetInjectionPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		subscription_id = "00000000-0000-0000-0000-000000000000"
+		resource_group = "network"
+		vnet_name = "-vnet"
+		`,
+	}.ExpectError(t, "vnet_name "+AzureNetworkResourceNameRegexError)
+}
@@ -0,0 +1,56 @@
+package azure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// azureRoleDefinition is the custom role definition in the format of `az role definition create`
+type azureRoleDefinition struct {
+	Name             string   `json:"Name"`
+	IsCustom         bool     `json:"IsCustom"`
+	Description      string   `json:"Description"`
+	Actions          []string `json:"Actions"`
+	NotActions       []string `json:"NotActions"`
+	DataActions      []string `json:"DataActions"`
+	NotDataActions   []string `json:"NotDataActions"`
+	AssignableScopes []string `json:"AssignableScopes"`
+}
+
+func (r azureRoleDefinition) json() (string, error) {
+	if r.NotActions == nil {
+		r.NotActions = []string{}
+	}
+	if r.DataActions == nil {
+		r.DataActions = []string{}
+	}
+	if r.NotDataActions == nil {
+		r.NotDataActions = []string{}
+	}
+	roleJSON, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(roleJSON), nil
+}
+
+// AzureRoleAssignment is a role, that has to be assigned to the principal at the given scope
+type AzureRoleAssignment struct {
+	RoleDefinitionName string `json:"role_definition_name"`
+	Scope              string `json:"scope"`
+}
+
+func validateSubscriptionAndResourceGroup(subscriptionId, resourceGroup string) error {
+	if !AzureSubscriptionIdRegex.MatchString(subscriptionId) {
+		return errors.New(AzureSubscriptionIdRegexError)
+	}
+	if !AzureResourceGroupNameRegex.MatchString(resourceGroup) {
+		return errors.New(AzureResourceGroupNameRegexError)
+	}
+	return nil
+}
+
+func resourceGroupId(subscriptionId, resourceGroup string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, resourceGroup)
+}
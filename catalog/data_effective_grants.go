@@ -0,0 +1,233 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/terraform-provider-databricks/catalog/permissions"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// EffectivePrivilege is a privilege that a principal has on a securable, either directly or through inheritance
+type EffectivePrivilege struct {
+	Principal         string `json:"principal"`
+	Privilege         string `json:"privilege"`
+	InheritedFromType string `json:"inherited_from_type,omitempty"`
+	InheritedFromName string `json:"inherited_from_name,omitempty"`
+	ViaGroup          string `json:"via_group,omitempty"`
+}
+
+// groupExpander resolves transitive members of workspace groups through SCIM API
+type groupExpander struct {
+	w       *databricks.WorkspaceClient
+	members map[string][]string
+	names   map[string]string
+	// names of groups that aren't found in the workspace, like account groups that aren't assigned to it
+	// or built-in groups like `account users`
+	unexpanded map[string]bool
+}
+
+func newGroupExpander(w *databricks.WorkspaceClient) *groupExpander {
+	return &groupExpander{
+		w:          w,
+		members:    map[string][]string{},
+		names:      map[string]string{},
+		unexpanded: map[string]bool{},
+	}
+}
+
+// scimFilterValueEscaper escapes values of string literals in SCIM filters
+var scimFilterValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// isUserOrServicePrincipal checks if the principal is a user name or an application ID of a service principal,
+// so it's not looked up as a group
+func isUserOrServicePrincipal(principal string) bool {
+	return strings.Contains(principal, "@") || common.StringIsUUID(principal)
+}
+
+// memberName returns the name of the user or the service principal, that is used as a principal in Unity Catalog
+func (e *groupExpander) memberName(ctx context.Context, member iam.ComplexValue) (string, error) {
+	if name, ok := e.names[member.Ref]; ok {
+		return name, nil
+	}
+	var name string
+	switch {
+	case strings.HasPrefix(member.Ref, "Users/"):
+		user, err := e.w.Users.GetById(ctx, member.Value)
+		if err != nil {
+			return "", err
+		}
+		name = user.UserName
+	case strings.HasPrefix(member.Ref, "ServicePrincipals/"):
+		sp, err := e.w.ServicePrincipals.GetById(ctx, member.Value)
+		if err != nil {
+			return "", err
+		}
+		name = sp.ApplicationId
+	}
+	e.names[member.Ref] = name
+	return name, nil
+}
+
+// transitiveMembers returns sorted names of users and service principals, that are members of the group or
+// of its nested groups. Users and service principals have no members, and groups that aren't found in the
+// workspace are recorded as unexpanded.
+func (e *groupExpander) transitiveMembers(ctx context.Context, group string) ([]string, error) {
+	if isUserOrServicePrincipal(group) {
+		return nil, nil
+	}
+	if members, ok := e.members[group]; ok {
+		return members, nil
+	}
+	groups, err := e.w.Groups.ListAll(ctx, iam.ListGroupsRequest{
+		Filter:     fmt.Sprintf(`displayName eq "%s"`, scimFilterValueEscaper.Replace(group)),
+		Attributes: "members",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		e.unexpanded[group] = true
+		e.members[group] = nil
+		return nil, nil
+	}
+	visited := map[string]bool{groups[0].Id: true}
+	found := map[string]bool{}
+	queue := []iam.Group{groups[0]}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, member := range current.Members {
+			if strings.HasPrefix(member.Ref, "Groups/") {
+				if visited[member.Value] {
+					continue
+				}
+				visited[member.Value] = true
+				nested, err := e.w.Groups.GetById(ctx, member.Value)
+				if apierr.IsMissing(err) {
+					e.unexpanded[member.Display] = true
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				queue = append(queue, *nested)
+				continue
+			}
+			name, err := e.memberName(ctx, member)
+			if err != nil {
+				return nil, err
+			}
+			if name != "" {
+				found[name] = true
+			}
+		}
+	}
+	members := make([]string, 0, len(found))
+	for name := range found {
+		members = append(members, name)
+	}
+	sort.Strings(members)
+	e.members[group] = members
+	return members, nil
+}
+
+func getEffectivePermissions(ctx context.Context, w *databricks.WorkspaceClient, securableType, fullName string) ([]catalog.EffectivePrivilegeAssignment, error) {
+	var assignments []catalog.EffectivePrivilegeAssignment
+	request := catalog.GetEffectiveRequest{
+		SecurableType: securableType,
+		FullName:      fullName,
+	}
+	for {
+		page, err := w.Grants.GetEffective(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, page.PrivilegeAssignments...)
+		if page.NextPageToken == "" {
+			return assignments, nil
+		}
+		request.PageToken = page.NextPageToken
+	}
+}
+
+// DataSourceEffectiveGrants returns privileges that principals have on a securable, including inherited ones
+func DataSourceEffectiveGrants() common.Resource {
+	type effectiveGrants struct {
+		common.Namespace
+		SecurableType string               `json:"securable_type"`
+		FullName      string               `json:"full_name"`
+		Principal     string               `json:"principal,omitempty"`
+		ExpandGroups  bool                 `json:"expand_groups,omitempty"`
+		Privileges    []EffectivePrivilege `json:"privileges,omitempty" tf:"computed"`
+		Principals    []string             `json:"principals,omitempty" tf:"computed"`
+		// groups with privileges that can't be expanded, because they aren't found in the workspace
+		UnexpandedGroups []string `json:"unexpanded_groups,omitempty" tf:"computed"`
+		Id               string   `json:"id,omitempty" tf:"computed"`
+	}
+	return common.WorkspaceData(func(ctx context.Context, data *effectiveGrants, w *databricks.WorkspaceClient) error {
+		securableType, ok := permissions.Mappings[data.SecurableType]
+		if !ok || data.SecurableType == "share" {
+			return fmt.Errorf("effective privileges of %s securables are not supported", data.SecurableType)
+		}
+		assignments, err := getEffectivePermissions(ctx, w, securableType.String(), data.FullName)
+		if err != nil {
+			return err
+		}
+		expander := newGroupExpander(w)
+		privileges := []EffectivePrivilege{}
+		for _, assignment := range assignments {
+			var members []string
+			if data.ExpandGroups {
+				members, err = expander.transitiveMembers(ctx, assignment.Principal)
+				if err != nil {
+					return err
+				}
+			}
+			for _, p := range assignment.Privileges {
+				privilege := EffectivePrivilege{
+					Principal:         assignment.Principal,
+					Privilege:         permissions.NormalizePrivilege(p.Privilege.String()),
+					InheritedFromType: p.InheritedFromType.String(),
+					InheritedFromName: p.InheritedFromName,
+				}
+				privileges = append(privileges, privilege)
+				for _, member := range members {
+					privilege.Principal = member
+					privilege.ViaGroup = assignment.Principal
+					privileges = append(privileges, privilege)
+				}
+			}
+		}
+		principals := map[string]bool{}
+		data.Privileges = []EffectivePrivilege{}
+		for _, privilege := range privileges {
+			if data.Principal != "" && privilege.Principal != data.Principal {
+				continue
+			}
+			data.Privileges = append(data.Privileges, privilege)
+			principals[privilege.Principal] = true
+		}
+		sort.SliceStable(data.Privileges, func(i, j int) bool {
+			return data.Privileges[i].Principal < data.Privileges[j].Principal
+		})
+		data.Principals = make([]string, 0, len(principals))
+		for principal := range principals {
+			data.Principals = append(data.Principals, principal)
+		}
+		sort.Strings(data.Principals)
+		data.UnexpandedGroups = make([]string, 0, len(expander.unexpanded))
+		for group := range expander.unexpanded {
+			data.UnexpandedGroups = append(data.UnexpandedGroups, group)
+		}
+		sort.Strings(data.UnexpandedGroups)
+		data.Id = fmt.Sprintf("%s/%s", data.SecurableType, data.FullName)
+		return nil
+	})
+}
@@ -0,0 +1,236 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockEffectivePermissions(w *mocks.MockWorkspaceClient) {
+	e := w.GetMockGrantsAPI().EXPECT()
+	e.GetEffective(mock.Anything, catalog.GetEffectiveRequest{
+		SecurableType: "table",
+		FullName:      "main.sales.orders",
+	}).Return(&catalog.EffectivePermissionsList{
+		PrivilegeAssignments: []catalog.EffectivePrivilegeAssignment{
+			{
+				Principal: "analysts",
+				Privileges: []catalog.EffectivePrivilege{
+					{
+						Privilege:         "SELECT",
+						InheritedFromType: "catalog",
+						InheritedFromName: "main",
+					},
+				},
+			},
+		},
+		NextPageToken: "next",
+	}, nil)
+	e.GetEffective(mock.Anything, catalog.GetEffectiveRequest{
+		SecurableType: "table",
+		FullName:      "main.sales.orders",
+		PageToken:     "next",
+	}).Return(&catalog.EffectivePermissionsList{
+		PrivilegeAssignments: []catalog.EffectivePrivilegeAssignment{
+			{
+				Principal: "bob@example.com",
+				Privileges: []catalog.EffectivePrivilege{
+					{
+						Privilege: "MODIFY",
+					},
+				},
+			},
+		},
+	}, nil)
+}
+
+func TestEffectiveGrantsData(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: mockEffectivePermissions,
+		Resource:                DataSourceEffectiveGrants(),
+		Read:                    true,
+		NonWritable:             true,
+		ID:                      "_",
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"id":                               "table/main.sales.orders",
+		"principals":                       []any{"analysts", "bob@example.com"},
+		"privileges.#":                     2,
+		"privileges.0.principal":           "analysts",
+		"privileges.0.privilege":           "SELECT",
+		"privileges.0.inherited_from_type": "catalog",
+		"privileges.0.inherited_from_name": "main",
+		"privileges.1.principal":           "bob@example.com",
+		"privileges.1.privilege":           "MODIFY",
+		"privileges.1.inherited_from_type": "",
+	})
+}
+
+func TestEffectiveGrantsData_ExpandGroups(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			mockEffectivePermissions(w)
+			g := w.GetMockGroupsAPI().EXPECT()
+			g.ListAll(mock.Anything, iam.ListGroupsRequest{
+				Filter:     `displayName eq "analysts"`,
+				Attributes: "members",
+			}).Return([]iam.Group{
+				{
+					Id: "4",
+					Members: []iam.ComplexValue{
+						{Ref: "Users/1", Value: "1"},
+						{Ref: "Groups/2", Value: "2", Display: "contractors"},
+						{Ref: "Groups/5", Value: "5", Display: "removed"},
+					},
+				},
+			}, nil)
+			g.GetById(mock.Anything, "2").Return(&iam.Group{
+				Id: "2",
+				Members: []iam.ComplexValue{
+					{Ref: "ServicePrincipals/3", Value: "3"},
+					{Ref: "Groups/4", Value: "4", Display: "analysts"},
+				},
+			}, nil)
+			g.GetById(mock.Anything, "5").Return(nil, &apierr.APIError{
+				ErrorCode:  "NOT_FOUND",
+				StatusCode: 404,
+				Message:    "Group with id 5 not found.",
+			})
+			w.GetMockUsersAPI().EXPECT().GetById(mock.Anything, "1").Return(&iam.User{
+				UserName: "alice@example.com",
+			}, nil)
+			w.GetMockServicePrincipalsAPI().EXPECT().GetById(mock.Anything, "3").Return(&iam.ServicePrincipal{
+				ApplicationId: "00000000-0000-0000-0000-000000000003",
+			}, nil)
+		},
+		Resource:    DataSourceEffectiveGrants(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		expand_groups  = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"principals": []any{
+			"00000000-0000-0000-0000-000000000003",
+			"alice@example.com",
+			"analysts",
+			"bob@example.com",
+		},
+		"privileges.#":                     4,
+		"privileges.0.principal":           "00000000-0000-0000-0000-000000000003",
+		"privileges.0.via_group":           "analysts",
+		"privileges.0.inherited_from_name": "main",
+		"privileges.1.principal":           "alice@example.com",
+		"privileges.1.privilege":           "SELECT",
+		"privileges.1.via_group":           "analysts",
+		"privileges.2.principal":           "analysts",
+		"privileges.2.via_group":           "",
+		"unexpanded_groups":                []any{"removed"},
+	})
+}
+
+func TestEffectiveGrantsData_UnexpandedGroups(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			w.GetMockGrantsAPI().EXPECT().GetEffective(mock.Anything, catalog.GetEffectiveRequest{
+				SecurableType: "table",
+				FullName:      "main.sales.orders",
+			}).Return(&catalog.EffectivePermissionsList{
+				PrivilegeAssignments: []catalog.EffectivePrivilegeAssignment{
+					{
+						Principal:  "account users",
+						Privileges: []catalog.EffectivePrivilege{{Privilege: "SELECT"}},
+					},
+					{
+						Principal:  `team "a"`,
+						Privileges: []catalog.EffectivePrivilege{{Privilege: "SELECT"}},
+					},
+					{
+						Principal:  "00000000-0000-0000-0000-000000000003",
+						Privileges: []catalog.EffectivePrivilege{{Privilege: "SELECT"}},
+					},
+				},
+			}, nil)
+			g := w.GetMockGroupsAPI().EXPECT()
+			g.ListAll(mock.Anything, iam.ListGroupsRequest{
+				Filter:     `displayName eq "account users"`,
+				Attributes: "members",
+			}).Return([]iam.Group{}, nil)
+			g.ListAll(mock.Anything, iam.ListGroupsRequest{
+				Filter:     `displayName eq "team \"a\""`,
+				Attributes: "members",
+			}).Return([]iam.Group{}, nil)
+		},
+		Resource:    DataSourceEffectiveGrants(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		expand_groups  = true
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"principals":        []any{"00000000-0000-0000-0000-000000000003", "account users", `team "a"`},
+		"privileges.#":      3,
+		"unexpanded_groups": []any{"account users", `team "a"`},
+	})
+}
+
+func TestEffectiveGrantsData_Principal(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: mockEffectivePermissions,
+		Resource:                DataSourceEffectiveGrants(),
+		Read:                    true,
+		NonWritable:             true,
+		ID:                      "_",
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		principal      = "bob@example.com"
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"principals":             []any{"bob@example.com"},
+		"privileges.#":           1,
+		"privileges.0.privilege": "MODIFY",
+	})
+}
+
+func TestEffectiveGrantsData_Share(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                DataSourceEffectiveGrants(),
+		Read:                    true,
+		NonWritable:             true,
+		ID:                      "_",
+		HCL: `
+		securable_type = "share"
+		full_name      = "sales"
+		`,
+	}.ExpectError(t, "effective privileges of share securables are not supported")
+}
+
+func TestEffectiveGrantsData_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    qa.HTTPFailures,
+		Resource:    DataSourceEffectiveGrants(),
+		Read:        true,
+		NonWritable: true,
+		ID:          "_",
+		HCL: `
+		securable_type = "table"
+		full_name      = "main.sales.orders"
+		`,
+	}.ExpectError(t, "i'm a teapot")
+}
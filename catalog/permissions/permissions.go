@@ -10,6 +10,7 @@ import (
 	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/sharing"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,9 +25,15 @@ func NewUnityCatalogPermissionsAPI(ctx context.Context, client *databricks.Works
 	return UnityCatalogPermissionsAPI{client, ctx}
 }
 
+// GetPermissions returns permissions of the securable, which may be cached if the provider is configured
+// with read_cache = true
 func (a UnityCatalogPermissionsAPI) GetPermissions(securable catalog.SecurableType, name string) (list *catalog.GetPermissionsResponse, err error) {
+	return a.getPermissions(common.WithReadCache(a.context), securable, name)
+}
+
+func (a UnityCatalogPermissionsAPI) getPermissions(ctx context.Context, securable catalog.SecurableType, name string) (list *catalog.GetPermissionsResponse, err error) {
 	if securable.String() == "share" {
-		sharePermissions, err := a.client.Shares.SharePermissions(a.context, sharing.SharePermissionsRequest{
+		sharePermissions, err := a.client.Shares.SharePermissions(ctx, sharing.SharePermissionsRequest{
 			Name: name,
 		})
 		if err != nil {
@@ -43,7 +50,7 @@ func (a UnityCatalogPermissionsAPI) GetPermissions(securable catalog.SecurableTy
 		}
 		return list, nil
 	}
-	list, err = a.client.Grants.GetBySecurableTypeAndFullName(a.context, securable.String(), name)
+	list, err = a.client.Grants.GetBySecurableTypeAndFullName(ctx, securable.String(), name)
 	return
 }
 
@@ -73,7 +80,8 @@ func (a UnityCatalogPermissionsAPI) UpdatePermissions(securable catalog.Securabl
 
 func (a UnityCatalogPermissionsAPI) WaitForUpdate(timeout time.Duration, securable catalog.SecurableType, name string, desired []catalog.PrivilegeAssignment, diff func([]catalog.PrivilegeAssignment, []catalog.PrivilegeAssignment) []catalog.PermissionsChange) error {
 	return retry.RetryContext(a.context, timeout, func() *retry.RetryError {
-		current, err := a.GetPermissions(securable, name)
+		// permissions are read without the cache, because they're expected to change
+		current, err := a.getPermissions(a.context, securable, name)
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
@@ -142,9 +150,20 @@ var Mappings = SecurableMapping{
 	"volume":             catalog.SecurableType("volume"),
 }
 
-// Unity Catalog accepts privileges with spaces, but will automatically convert them to underscores
+// privilegeAliases map legacy spellings of privileges to the names returned by Unity Catalog
+var privilegeAliases = map[string]string{
+	"ALL":                   "ALL_PRIVILEGES",
+	"CREATE_NAMED_FUNCTION": "CREATE_FUNCTION",
+}
+
+// Unity Catalog accepts privileges with spaces, but will automatically convert them to underscores.
+// Hyphens, repeated spaces and legacy spellings, like `ALL` or `CREATE_NAMED_FUNCTION` from table ACLs, are normalized too.
 func NormalizePrivilege(privilege string) string {
-	return strings.ToUpper(strings.Replace(privilege, " ", "_", -1))
+	normalized := strings.ToUpper(strings.Join(strings.Fields(strings.ReplaceAll(privilege, "-", " ")), "_"))
+	if alias, ok := privilegeAliases[normalized]; ok {
+		return alias
+	}
+	return normalized
 }
 
 // Utils for Slice and Set
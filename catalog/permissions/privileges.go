@@ -0,0 +1,150 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Privileges are privileges applicable to each securable in Unity Catalog with Privilege Model version 1.0,
+// keyed by the securable attribute of grant resources. Privileges of catalogs and schemas include privileges
+// of securables in them, because they're inherited. Securables without an entry aren't validated.
+// See https://docs.databricks.com/en/data-governance/unity-catalog/manage-privileges/privileges.html
+var Privileges = map[string][]string{
+	"metastore": {
+		"CREATE_CATALOG", "CREATE_CLEAN_ROOM", "CREATE_CONNECTION", "CREATE_EXTERNAL_LOCATION",
+		"CREATE_PROVIDER", "CREATE_RECIPIENT", "CREATE_SERVICE_CREDENTIAL", "CREATE_SHARE",
+		"CREATE_STORAGE_CREDENTIAL", "MANAGE_ALLOWLIST", "SET_SHARE_PERMISSION", "USE_MARKETPLACE_ASSETS",
+		"USE_PROVIDER", "USE_RECIPIENT", "USE_SHARE",
+	},
+	"catalog": {
+		"ALL_PRIVILEGES", "APPLY_TAG", "BROWSE", "CREATE_CONNECTION", "CREATE_FOREIGN_SECURABLE",
+		"CREATE_FUNCTION", "CREATE_MATERIALIZED_VIEW", "CREATE_MODEL", "CREATE_MODEL_VERSION", "CREATE_SCHEMA",
+		"CREATE_TABLE", "CREATE_VOLUME", "EXECUTE", "EXTERNAL_USE_SCHEMA", "MANAGE", "MODIFY", "READ_VOLUME",
+		"REFRESH", "SELECT", "USE_CATALOG", "USE_SCHEMA", "WRITE_VOLUME",
+	},
+	"schema": {
+		"ALL_PRIVILEGES", "APPLY_TAG", "CREATE_FUNCTION", "CREATE_MATERIALIZED_VIEW", "CREATE_MODEL",
+		"CREATE_MODEL_VERSION", "CREATE_TABLE", "CREATE_VOLUME", "EXECUTE", "EXTERNAL_USE_SCHEMA", "MANAGE",
+		"MODIFY", "READ_VOLUME", "REFRESH", "SELECT", "USE_SCHEMA", "WRITE_VOLUME",
+	},
+	"table": {
+		"ALL_PRIVILEGES", "APPLY_TAG", "MANAGE", "MODIFY", "REFRESH", "SELECT",
+	},
+	"volume": {
+		"ALL_PRIVILEGES", "APPLY_TAG", "MANAGE", "READ_VOLUME", "WRITE_VOLUME",
+	},
+	"model": {
+		"ALL_PRIVILEGES", "APPLY_TAG", "CREATE_MODEL_VERSION", "EXECUTE", "MANAGE",
+	},
+	"function": {
+		"ALL_PRIVILEGES", "EXECUTE", "MANAGE",
+	},
+	"credential": {
+		"ACCESS", "ALL_PRIVILEGES", "CREATE_CONNECTION", "MANAGE",
+	},
+	"storage_credential": {
+		"ALL_PRIVILEGES", "CREATE_EXTERNAL_LOCATION", "CREATE_EXTERNAL_TABLE", "MANAGE", "READ_FILES",
+		"WRITE_FILES",
+	},
+	"external_location": {
+		"ALL_PRIVILEGES", "BROWSE", "CREATE_EXTERNAL_TABLE", "CREATE_EXTERNAL_VOLUME", "CREATE_FOREIGN_SECURABLE",
+		"CREATE_MANAGED_STORAGE", "EXTERNAL_USE_LOCATION", "MANAGE", "READ_FILES", "WRITE_FILES",
+	},
+	"foreign_connection": {
+		"ALL_PRIVILEGES", "CREATE_FOREIGN_CATALOG", "CREATE_FOREIGN_SECURABLE", "MANAGE", "USE_CONNECTION",
+	},
+	"share": {
+		"SELECT",
+	},
+}
+
+// legacyPrivileges are privileges of Privilege Model version 0.1, that were replaced by different privileges
+// depending on the securable. They're rejected with the replacement instead of being mapped: Unity Catalog
+// doesn't accept them anymore, and the privileges set is hashed without knowing the securable, so a mapped
+// privilege would show up as a permanent diff against the privilege returned by the API.
+var legacyPrivileges = map[string]map[string]string{
+	"catalog": {
+		"USAGE":  "USE_CATALOG",
+		"CREATE": "CREATE_SCHEMA",
+	},
+	"schema": {
+		"USAGE":  "USE_SCHEMA",
+		"CREATE": "CREATE_TABLE",
+	},
+}
+
+// ValidatePrivileges returns an error with a suggestion, if any of the privileges isn't applicable to the securable.
+// Privileges unknown to the provider only produce a warning, because Unity Catalog may add new ones.
+func ValidatePrivileges(securable string, privileges *schema.Set) error {
+	valid, ok := Privileges[securable]
+	if !ok {
+		return nil
+	}
+	for _, v := range privileges.List() {
+		privilege := NormalizePrivilege(v.(string))
+		if privilege == "" || slices.Contains(valid, privilege) {
+			continue
+		}
+		if replacement, ok := legacyPrivileges[securable][privilege]; ok {
+			return fmt.Errorf("privilege %s is not applicable to %s, use %s instead", v, securable, replacement)
+		}
+		if suggestion := closestPrivilege(privilege, valid); suggestion != "" {
+			return fmt.Errorf("privilege %s is not applicable to %s, did you mean %s?", v, securable, suggestion)
+		}
+		if !isKnownPrivilege(privilege) {
+			log.Printf("[WARN] privilege %s is unknown for %s and is passed to Unity Catalog as is", v, securable)
+			continue
+		}
+		return fmt.Errorf("privilege %s is not applicable to %s. Applicable privileges are: %s",
+			v, securable, strings.Join(valid, ", "))
+	}
+	return nil
+}
+
+// isKnownPrivilege returns true, if the privilege is applicable to any of the validated securables
+func isKnownPrivilege(privilege string) bool {
+	for _, valid := range Privileges {
+		if slices.Contains(valid, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+// closestPrivilege returns the privilege with the smallest edit distance, if the distance is small enough to be a typo
+func closestPrivilege(privilege string, candidates []string) string {
+	closest := ""
+	best := len(privilege)/3 + 1
+	for _, candidate := range candidates {
+		if distance := editDistance(privilege, candidate); distance < best {
+			closest = candidate
+			best = distance
+		}
+	}
+	return closest
+}
+
+// editDistance is the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,57 @@
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func privilegesSet(privileges ...string) *schema.Set {
+	out := []any{}
+	for _, p := range privileges {
+		out = append(out, p)
+	}
+	return schema.NewSet(schema.HashString, out)
+}
+
+func TestNormalizePrivilege(t *testing.T) {
+	assert.Equal(t, "USE_CATALOG", NormalizePrivilege("use catalog"))
+	assert.Equal(t, "USE_SCHEMA", NormalizePrivilege("use-schema"))
+	assert.Equal(t, "ALL_PRIVILEGES", NormalizePrivilege("ALL"))
+	assert.Equal(t, "ALL_PRIVILEGES", NormalizePrivilege("all privileges"))
+	assert.Equal(t, "CREATE_FUNCTION", NormalizePrivilege("CREATE NAMED FUNCTION"))
+}
+
+func TestValidatePrivileges(t *testing.T) {
+	assert.NoError(t, ValidatePrivileges("catalog", privilegesSet("USE_CATALOG", "use schema", "SELECT")))
+	assert.NoError(t, ValidatePrivileges("table", privilegesSet("ALL")))
+	assert.NoError(t, ValidatePrivileges("pipeline", privilegesSet("ANYTHING")))
+}
+
+func TestValidatePrivilegesLegacy(t *testing.T) {
+	assert.EqualError(t, ValidatePrivileges("catalog", privilegesSet("USAGE")),
+		"privilege USAGE is not applicable to catalog, use USE_CATALOG instead")
+	assert.EqualError(t, ValidatePrivileges("schema", privilegesSet("CREATE")),
+		"privilege CREATE is not applicable to schema, use CREATE_TABLE instead")
+}
+
+func TestValidatePrivilegesTypo(t *testing.T) {
+	assert.EqualError(t, ValidatePrivileges("table", privilegesSet("SELET")),
+		"privilege SELET is not applicable to table, did you mean SELECT?")
+	assert.EqualError(t, ValidatePrivileges("volume", privilegesSet("READ_VOLUMES")),
+		"privilege READ_VOLUMES is not applicable to volume, did you mean READ_VOLUME?")
+}
+
+func TestValidatePrivilegesNotApplicable(t *testing.T) {
+	assert.EqualError(t, ValidatePrivileges("table", privilegesSet("USE_CATALOG")),
+		"privilege USE_CATALOG is not applicable to table. "+
+			"Applicable privileges are: ALL_PRIVILEGES, APPLY_TAG, MANAGE, MODIFY, REFRESH, SELECT")
+	assert.EqualError(t, ValidatePrivileges("function", privilegesSet("READ_VOLUME")),
+		"privilege READ_VOLUME is not applicable to function. Applicable privileges are: ALL_PRIVILEGES, EXECUTE, MANAGE")
+}
+
+func TestValidatePrivilegesUnknown(t *testing.T) {
+	assert.NoError(t, ValidatePrivileges("table", privilegesSet("VIEW_LINEAGE")))
+	assert.NoError(t, ValidatePrivileges("metastore", privilegesSet("CREATE_CATALOG", "ACCESS_EXTERNAL_SOURCES")))
+}
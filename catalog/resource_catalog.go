@@ -3,8 +3,10 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"iter"
 	"log"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/catalog/bindings"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -33,6 +35,22 @@ type CatalogSchemaStruct struct {
 	common.Namespace
 }
 
+// ListCatalogs lists the catalogs of the current metastore that could be managed by databricks_catalog,
+// skipping system, internal and other catalog types that can't be managed by it.
+func ListCatalogs(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[catalog.CatalogInfo, error] {
+	return common.ListingSeq(ctx, w.Catalogs.List(ctx, catalog.ListCatalogsRequest{}), IsListedCatalog)
+}
+
+// IsListedCatalog returns true for catalogs of types that are managed by databricks_catalog
+func IsListedCatalog(ci catalog.CatalogInfo) bool {
+	switch ci.CatalogType {
+	case catalog.CatalogTypeManagedCatalog, catalog.CatalogTypeForeignCatalog, catalog.CatalogTypeDeltasharingCatalog:
+		return true
+	}
+	log.Printf("[INFO] Skipping catalog %s of type %s", ci.Name, ci.CatalogType)
+	return false
+}
+
 func ResourceCatalog() common.Resource {
 	catalogSchema := common.StructToSchema(CatalogSchemaStruct{},
 		func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -70,7 +88,8 @@ func ResourceCatalog() common.Resource {
 			return s
 		})
 	return common.Resource{
-		Schema: catalogSchema,
+		Schema:             catalogSchema,
+		DeletionProtection: true,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
 			if err != nil {
@@ -264,5 +283,6 @@ func ResourceCatalog() common.Resource {
 			}
 			return nil
 		},
+		WithIdentity: true,
 	}
 }
@@ -0,0 +1,298 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/catalog/permissions"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// catalogTreeSecurableTypes are types of securables managed by databricks_catalog_tree_grants,
+// in the order they're walked
+var catalogTreeSecurableTypes = []string{"catalog", "schema", "table", "volume", "function", "model"}
+
+// TreePrivilegeAssignment reflects on `grant` block of databricks_catalog_tree_grants
+type TreePrivilegeAssignment struct {
+	Principal     string   `json:"principal"`
+	Privileges    []string `json:"privileges" tf:"slice_set"`
+	SecurableType string   `json:"securable_type,omitempty"`
+}
+
+// TreePermissionsDrift is a difference between desired and actual privileges of a principal on a securable
+type TreePermissionsDrift struct {
+	SecurableType string   `json:"securable_type"`
+	FullName      string   `json:"full_name"`
+	Principal     string   `json:"principal"`
+	Add           []string `json:"add,omitempty"`
+	Remove        []string `json:"remove,omitempty"`
+}
+
+// CatalogTreeGrants authoritatively manages privileges on all securables in a catalog or a schema
+type CatalogTreeGrants struct {
+	Catalog     string                    `json:"catalog" tf:"force_new"`
+	Schema      string                    `json:"schema,omitempty" tf:"force_new"`
+	Assignments []TreePrivilegeAssignment `json:"grant,omitempty" tf:"slice_set"`
+	Drift       []TreePermissionsDrift    `json:"drift" tf:"computed"`
+	common.Namespace
+}
+
+func (t CatalogTreeGrants) id() string {
+	if t.Schema == "" {
+		return t.Catalog
+	}
+	return t.Catalog + "." + t.Schema
+}
+
+func (t CatalogTreeGrants) rootSecurableType() string {
+	if t.Schema == "" {
+		return "catalog"
+	}
+	return "schema"
+}
+
+// desiredAssignments returns desired privilege assignments for every type of securables in the tree
+func (t CatalogTreeGrants) desiredAssignments() (map[string][]catalog.PrivilegeAssignment, error) {
+	byType := map[string]map[string][]catalog.Privilege{}
+	for _, a := range t.Assignments {
+		securableType := a.SecurableType
+		if securableType == "" {
+			securableType = t.rootSecurableType()
+		}
+		if securableType == "catalog" && t.Schema != "" {
+			return nil, fmt.Errorf("catalog privileges can't be managed for schema %s", t.id())
+		}
+		if byType[securableType] == nil {
+			byType[securableType] = map[string][]catalog.Privilege{}
+		}
+		for _, p := range a.Privileges {
+			byType[securableType][a.Principal] = append(byType[securableType][a.Principal],
+				catalog.Privilege(permissions.NormalizePrivilege(p)))
+		}
+	}
+	desired := map[string][]catalog.PrivilegeAssignment{}
+	for securableType, byPrincipal := range byType {
+		for principal, privileges := range byPrincipal {
+			desired[securableType] = append(desired[securableType], catalog.PrivilegeAssignment{
+				Principal:  principal,
+				Privileges: privileges,
+			})
+		}
+	}
+	return desired, nil
+}
+
+type treeSecurable struct {
+	securableType string
+	fullName      string
+}
+
+// listCatalogTree returns the catalog or the schema with all schemas, tables, volumes, functions and models in it
+func listCatalogTree(ctx context.Context, w *databricks.WorkspaceClient, catalogName, schemaName string) ([]treeSecurable, error) {
+	var securables []treeSecurable
+	var schemas []string
+	if schemaName == "" {
+		securables = append(securables, treeSecurable{"catalog", catalogName})
+		all, err := w.Schemas.ListAll(ctx, catalog.ListSchemasRequest{CatalogName: catalogName})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range all {
+			// privileges on the information schema can't be changed
+			if s.Name == "information_schema" {
+				continue
+			}
+			schemas = append(schemas, s.Name)
+		}
+		sort.Strings(schemas)
+	} else {
+		schemas = []string{schemaName}
+	}
+	for _, schemaName := range schemas {
+		securables = append(securables, treeSecurable{"schema", catalogName + "." + schemaName})
+		var names []treeSecurable
+		tables, err := w.Tables.ListAll(ctx, catalog.ListTablesRequest{CatalogName: catalogName, SchemaName: schemaName})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range tables {
+			names = append(names, treeSecurable{"table", v.FullName})
+		}
+		volumes, err := w.Volumes.ListAll(ctx, catalog.ListVolumesRequest{CatalogName: catalogName, SchemaName: schemaName})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range volumes {
+			names = append(names, treeSecurable{"volume", v.FullName})
+		}
+		functions, err := w.Functions.ListAll(ctx, catalog.ListFunctionsRequest{CatalogName: catalogName, SchemaName: schemaName})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range functions {
+			names = append(names, treeSecurable{"function", v.FullName})
+		}
+		models, err := w.RegisteredModels.ListAll(ctx, catalog.ListRegisteredModelsRequest{CatalogName: catalogName, SchemaName: schemaName})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range models {
+			names = append(names, treeSecurable{"model", v.FullName})
+		}
+		sort.SliceStable(names, func(i, j int) bool {
+			return names[i].fullName < names[j].fullName
+		})
+		securables = append(securables, names...)
+	}
+	return securables, nil
+}
+
+// syncCatalogTree compares privileges of all securables in the tree with the desired ones and returns the drift.
+// If apply is true, privileges that are not desired are revoked and missing ones are granted.
+func syncCatalogTree(ctx context.Context, w *databricks.WorkspaceClient, tree CatalogTreeGrants,
+	desired map[string][]catalog.PrivilegeAssignment, apply bool) ([]TreePermissionsDrift, error) {
+	securables, err := listCatalogTree(ctx, w, tree.Catalog, tree.Schema)
+	if err != nil {
+		return nil, err
+	}
+	a := permissions.NewUnityCatalogPermissionsAPI(ctx, w)
+	drift := []TreePermissionsDrift{}
+	for _, securable := range securables {
+		securableType := permissions.Mappings.GetSecurableType(securable.securableType)
+		existing, err := a.GetPermissions(securableType, securable.fullName)
+		if err != nil {
+			return nil, err
+		}
+		diff := diffPermissions(desired[securable.securableType], existing.PrivilegeAssignments)
+		for _, change := range diff {
+			drift = append(drift, TreePermissionsDrift{
+				SecurableType: securable.securableType,
+				FullName:      securable.fullName,
+				Principal:     change.Principal,
+				Add:           privilegesToStrings(change.Add),
+				Remove:        privilegesToStrings(change.Remove),
+			})
+		}
+		if !apply {
+			continue
+		}
+		err = applyPermissionsDiff(a, securableType, securable.fullName, desired[securable.securableType], diff)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", securable.securableType, securable.fullName, err)
+		}
+	}
+	return drift, nil
+}
+
+func privilegesToStrings(privileges []catalog.Privilege) []string {
+	out := []string{}
+	for _, p := range privileges {
+		out = append(out, p.String())
+	}
+	sort.Strings(out)
+	return out
+}
+
+func ResourceCatalogTreeGrants() common.Resource {
+	s := common.StructToSchema(CatalogTreeGrants{},
+		func(s map[string]*schema.Schema) map[string]*schema.Schema {
+			common.MustSchemaPath(s, "grant", "privileges").Set = func(i any) int {
+				return schema.HashString(permissions.NormalizePrivilege(i.(string)))
+			}
+			common.MustSchemaPath(s, "grant").Set = func(i any) int {
+				objectStruct := i.(map[string]any)
+				principal := objectStruct["principal"].(string)
+				privileges := objectStruct["privileges"].(*schema.Set)
+				hashString := strings.ToLower(principal) + "|" + objectStruct["securable_type"].(string)
+				for _, privilege := range privileges.List() {
+					hashString += "|" + permissions.NormalizePrivilege(privilege.(string))
+				}
+				return schema.HashString(hashString)
+			}
+			common.MustSchemaPath(s, "grant", "securable_type").ValidateFunc =
+				validation.StringInSlice(catalogTreeSecurableTypes, false)
+			common.NamespaceCustomizeSchemaMap(s)
+			return s
+		})
+	syncGrants := func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient, apply bool) error {
+		w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
+		if err != nil {
+			return err
+		}
+		var tree CatalogTreeGrants
+		common.DataToStructPointer(d, s, &tree)
+		desired, err := tree.desiredAssignments()
+		if err != nil {
+			return err
+		}
+		drift, err := syncCatalogTree(ctx, w, tree, desired, apply)
+		if err != nil {
+			return err
+		}
+		if apply {
+			// drift was fixed
+			drift = []TreePermissionsDrift{}
+		}
+		tree.Drift = drift
+		return common.StructToData(tree, s, d)
+	}
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if d.NewValueKnown("grant") {
+				root := CatalogTreeGrants{Schema: d.Get("schema").(string)}.rootSecurableType()
+				for _, v := range d.Get("grant").(*schema.Set).List() {
+					grant := v.(map[string]any)
+					securableType := grant["securable_type"].(string)
+					if securableType == "" {
+						securableType = root
+					}
+					err := permissions.ValidatePrivileges(securableType, grant["privileges"].(*schema.Set))
+					if err != nil {
+						return err
+					}
+				}
+			}
+			// drift found during refresh is fixed by the update
+			if d.Get("drift.#").(int) > 0 {
+				return d.SetNew("drift", []any{})
+			}
+			return nil
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if err := syncGrants(ctx, d, c, true); err != nil {
+				return err
+			}
+			d.SetId(CatalogTreeGrants{
+				Catalog: d.Get("catalog").(string),
+				Schema:  d.Get("schema").(string),
+			}.id())
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			catalogName, schemaName, _ := strings.Cut(d.Id(), ".")
+			d.Set("catalog", catalogName)
+			d.Set("schema", schemaName)
+			return syncGrants(ctx, d, c, false)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return syncGrants(ctx, d, c, true)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
+			if err != nil {
+				return err
+			}
+			catalogName, schemaName, _ := strings.Cut(d.Id(), ".")
+			_, err = syncCatalogTree(ctx, w, CatalogTreeGrants{Catalog: catalogName, Schema: schemaName},
+				map[string][]catalog.PrivilegeAssignment{}, true)
+			return err
+		},
+	}
+}
@@ -0,0 +1,276 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCatalogTreeGrantsCornerCases(t *testing.T) {
+	qa.ResourceCornerCases(t, ResourceCatalogTreeGrants(), qa.CornerCaseID("main"))
+}
+
+func mockCatalogTree(w *mocks.MockWorkspaceClient) {
+	w.GetMockSchemasAPI().EXPECT().ListAll(mock.Anything, catalog.ListSchemasRequest{
+		CatalogName: "main",
+	}).Return([]catalog.SchemaInfo{
+		{Name: "sales"},
+		{Name: "information_schema"},
+	}, nil)
+	mockSchemaTree(w)
+}
+
+func mockSchemaTree(w *mocks.MockWorkspaceClient) {
+	w.GetMockTablesAPI().EXPECT().ListAll(mock.Anything, catalog.ListTablesRequest{
+		CatalogName: "main",
+		SchemaName:  "sales",
+	}).Return([]catalog.TableInfo{
+		{FullName: "main.sales.orders"},
+	}, nil)
+	w.GetMockVolumesAPI().EXPECT().ListAll(mock.Anything, catalog.ListVolumesRequest{
+		CatalogName: "main",
+		SchemaName:  "sales",
+	}).Return([]catalog.VolumeInfo{}, nil)
+	w.GetMockFunctionsAPI().EXPECT().ListAll(mock.Anything, catalog.ListFunctionsRequest{
+		CatalogName: "main",
+		SchemaName:  "sales",
+	}).Return([]catalog.FunctionInfo{}, nil)
+	w.GetMockRegisteredModelsAPI().EXPECT().ListAll(mock.Anything, catalog.ListRegisteredModelsRequest{
+		CatalogName: "main",
+		SchemaName:  "sales",
+	}).Return([]catalog.RegisteredModelInfo{
+		{FullName: "main.sales.churn"},
+	}, nil)
+}
+
+func permissionsOf(assignments ...catalog.PrivilegeAssignment) *catalog.GetPermissionsResponse {
+	return &catalog.GetPermissionsResponse{PrivilegeAssignments: assignments}
+}
+
+func TestCatalogTreeGrantsCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			mockCatalogTree(w)
+			e := w.GetMockGrantsAPI().EXPECT()
+			desiredCatalog := permissionsOf(catalog.PrivilegeAssignment{
+				Principal:  "data-engineers",
+				Privileges: []catalog.Privilege{"USE_CATALOG", "USE_SCHEMA"},
+			})
+			e.GetBySecurableTypeAndFullName(mock.Anything, "catalog", "main").Return(permissionsOf(
+				catalog.PrivilegeAssignment{
+					Principal:  "data-engineers",
+					Privileges: []catalog.Privilege{"USE_CATALOG"},
+				},
+				catalog.PrivilegeAssignment{
+					Principal:  "someone",
+					Privileges: []catalog.Privilege{"ALL_PRIVILEGES"},
+				}), nil).Once()
+			e.Update(mock.Anything, catalog.UpdatePermissions{
+				SecurableType: "catalog",
+				FullName:      "main",
+				Changes: []catalog.PermissionsChange{
+					{
+						Principal: "data-engineers",
+						Add:       []catalog.Privilege{"USE_SCHEMA"},
+					},
+					{
+						Principal: "someone",
+						Remove:    []catalog.Privilege{"ALL_PRIVILEGES"},
+					},
+				},
+			}).Return(&catalog.UpdatePermissionsResponse{}, nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "catalog", "main").Return(desiredCatalog, nil)
+
+			e.GetBySecurableTypeAndFullName(mock.Anything, "schema", "main.sales").Return(permissionsOf(), nil)
+
+			e.GetBySecurableTypeAndFullName(mock.Anything, "table", "main.sales.orders").Return(permissionsOf(
+				catalog.PrivilegeAssignment{
+					Principal:  "analyst",
+					Privileges: []catalog.Privilege{"SELECT"},
+				}), nil).Once()
+			e.Update(mock.Anything, catalog.UpdatePermissions{
+				SecurableType: "table",
+				FullName:      "main.sales.orders",
+				Changes: []catalog.PermissionsChange{
+					{
+						Principal: "analyst",
+						Remove:    []catalog.Privilege{"SELECT"},
+					},
+				},
+			}).Return(&catalog.UpdatePermissionsResponse{}, nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "table", "main.sales.orders").Return(permissionsOf(), nil)
+
+			desiredModel := permissionsOf(catalog.PrivilegeAssignment{
+				Principal:  "ml",
+				Privileges: []catalog.Privilege{"EXECUTE"},
+			})
+			e.GetBySecurableTypeAndFullName(mock.Anything, "function", "main.sales.churn").Return(permissionsOf(), nil).Once()
+			e.Update(mock.Anything, catalog.UpdatePermissions{
+				SecurableType: "function",
+				FullName:      "main.sales.churn",
+				Changes: []catalog.PermissionsChange{
+					{
+						Principal: "ml",
+						Add:       []catalog.Privilege{"EXECUTE"},
+					},
+				},
+			}).Return(&catalog.UpdatePermissionsResponse{}, nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "function", "main.sales.churn").Return(desiredModel, nil)
+		},
+		Resource: ResourceCatalogTreeGrants(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+		grant {
+			principal  = "data-engineers"
+			privileges = ["USE_CATALOG", "USE SCHEMA"]
+		}
+		grant {
+			principal      = "ml"
+			privileges     = ["EXECUTE"]
+			securable_type = "model"
+		}
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "main", d.Id())
+	assert.Equal(t, 0, d.Get("drift.#"))
+}
+
+func TestCatalogTreeGrantsReadDrift(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			mockSchemaTree(w)
+			e := w.GetMockGrantsAPI().EXPECT()
+			e.GetBySecurableTypeAndFullName(mock.Anything, "schema", "main.sales").Return(permissionsOf(
+				catalog.PrivilegeAssignment{
+					Principal:  "data-engineers",
+					Privileges: []catalog.Privilege{"USE_SCHEMA"},
+				}), nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "table", "main.sales.orders").Return(permissionsOf(
+				catalog.PrivilegeAssignment{
+					Principal:  "analyst",
+					Privileges: []catalog.Privilege{"SELECT", "MODIFY"},
+				}), nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "function", "main.sales.churn").Return(permissionsOf(), nil)
+		},
+		Resource: ResourceCatalogTreeGrants(),
+		Read:     true,
+		New:      true,
+		ID:       "main.sales",
+		HCL: `
+		catalog = "main"
+		schema  = "sales"
+		grant {
+			principal  = "data-engineers"
+			privileges = ["USE_SCHEMA"]
+		}
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"catalog":                "main",
+		"schema":                 "sales",
+		"drift.#":                1,
+		"drift.0.securable_type": "table",
+		"drift.0.full_name":      "main.sales.orders",
+		"drift.0.principal":      "analyst",
+		"drift.0.remove":         []any{"MODIFY", "SELECT"},
+		"grant.#":                1,
+	})
+}
+
+func TestCatalogTreeGrantsDriftIsPlannedForUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalogTreeGrants(),
+		ID:       "main",
+		InstanceState: map[string]string{
+			"catalog":                "main",
+			"drift.#":                "1",
+			"drift.0.securable_type": "table",
+			"drift.0.full_name":      "main.sales.orders",
+			"drift.0.principal":      "analyst",
+			"drift.0.remove.#":       "1",
+			"drift.0.remove.0":       "SELECT",
+		},
+		HCL: `catalog = "main"`,
+		ExpectedDiff: map[string]*terraform.ResourceAttrDiff{
+			"drift.#":                {Old: "1", New: "0"},
+			"drift.0.securable_type": {Old: "table", New: "", NewRemoved: true},
+			"drift.0.full_name":      {Old: "main.sales.orders", New: "", NewRemoved: true},
+			"drift.0.principal":      {Old: "analyst", New: "", NewRemoved: true},
+			"drift.0.remove.#":       {Old: "1", New: "0"},
+			"drift.0.remove.0":       {Old: "SELECT", New: "", NewRemoved: true},
+		},
+	}.ApplyNoError(t)
+}
+
+func TestCatalogTreeGrantsCatalogPrivilegesOfSchema(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {},
+		Resource:                ResourceCatalogTreeGrants(),
+		Create:                  true,
+		HCL: `
+		catalog = "main"
+		schema  = "sales"
+		grant {
+			principal      = "data-engineers"
+			privileges     = ["USE_CATALOG"]
+			securable_type = "catalog"
+		}
+		`,
+	}.ExpectError(t, "catalog privileges can't be managed for schema main.sales")
+}
+
+func TestCatalogTreeGrantsDelete(t *testing.T) {
+	qa.ResourceFixture{
+		MockWorkspaceClientFunc: func(w *mocks.MockWorkspaceClient) {
+			mockSchemaTree(w)
+			e := w.GetMockGrantsAPI().EXPECT()
+			e.GetBySecurableTypeAndFullName(mock.Anything, "schema", "main.sales").Return(permissionsOf(
+				catalog.PrivilegeAssignment{
+					Principal:  "data-engineers",
+					Privileges: []catalog.Privilege{"USE_SCHEMA"},
+				}), nil).Once()
+			e.Update(mock.Anything, catalog.UpdatePermissions{
+				SecurableType: "schema",
+				FullName:      "main.sales",
+				Changes: []catalog.PermissionsChange{
+					{
+						Principal: "data-engineers",
+						Remove:    []catalog.Privilege{"USE_SCHEMA"},
+					},
+				},
+			}).Return(&catalog.UpdatePermissionsResponse{}, nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "schema", "main.sales").Return(permissionsOf(), nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "table", "main.sales.orders").Return(permissionsOf(), nil)
+			e.GetBySecurableTypeAndFullName(mock.Anything, "function", "main.sales.churn").Return(permissionsOf(), nil)
+		},
+		Resource: ResourceCatalogTreeGrants(),
+		Delete:   true,
+		ID:       "main.sales",
+		HCL: `
+		catalog = "main"
+		schema  = "sales"
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestCatalogTreeGrantsInvalidPrivilege(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceCatalogTreeGrants(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+
+		grant {
+			principal = "me"
+			securable_type = "volume"
+			privileges = ["SELECT"]
+		}`,
+	}.ExpectError(t, "privilege SELECT is not applicable to volume. "+
+		"Applicable privileges are: ALL_PRIVILEGES, APPLY_TAG, MANAGE, READ_VOLUME, WRITE_VOLUME")
+}
@@ -166,6 +166,12 @@ func ResourceGrant() common.Resource {
 
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if !d.NewValueKnown("privileges") {
+				return nil
+			}
+			return permissions.ValidatePrivileges(grantedSecurable(d), d.Get("privileges").(*schema.Set))
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
 			if err != nil {
@@ -105,11 +105,11 @@ func TestResourceGrantCreateMetastoreId(t *testing.T) {
 					PrivilegeAssignments: []catalog.PrivilegeAssignment{
 						{
 							Principal:  "me",
-							Privileges: []catalog.Privilege{"SELECT"},
+							Privileges: []catalog.Privilege{"CREATE_SHARE"},
 						},
 						{
 							Principal:  "someone-else",
-							Privileges: []catalog.Privilege{"MODIFY", "SELECT"},
+							Privileges: []catalog.Privilege{"CREATE_CATALOG", "CREATE_SHARE"},
 						},
 					},
 				},
@@ -121,8 +121,8 @@ func TestResourceGrantCreateMetastoreId(t *testing.T) {
 					Changes: []catalog.PermissionsChange{
 						{
 							Principal: "me",
-							Add:       []catalog.Privilege{"MODIFY"},
-							Remove:    []catalog.Privilege{"SELECT"},
+							Add:       []catalog.Privilege{"CREATE_CATALOG"},
+							Remove:    []catalog.Privilege{"CREATE_SHARE"},
 						},
 					},
 				},
@@ -134,11 +134,11 @@ func TestResourceGrantCreateMetastoreId(t *testing.T) {
 					PrivilegeAssignments: []catalog.PrivilegeAssignment{
 						{
 							Principal:  "me",
-							Privileges: []catalog.Privilege{"MODIFY"},
+							Privileges: []catalog.Privilege{"CREATE_CATALOG"},
 						},
 						{
 							Principal:  "someone-else",
-							Privileges: []catalog.Privilege{"MODIFY", "SELECT"},
+							Privileges: []catalog.Privilege{"CREATE_CATALOG", "CREATE_SHARE"},
 						},
 					},
 				},
@@ -150,11 +150,11 @@ func TestResourceGrantCreateMetastoreId(t *testing.T) {
 					PrivilegeAssignments: []catalog.PrivilegeAssignment{
 						{
 							Principal:  "me",
-							Privileges: []catalog.Privilege{"MODIFY"},
+							Privileges: []catalog.Privilege{"CREATE_CATALOG"},
 						},
 						{
 							Principal:  "someone-else",
-							Privileges: []catalog.Privilege{"MODIFY", "SELECT"},
+							Privileges: []catalog.Privilege{"CREATE_CATALOG", "CREATE_SHARE"},
 						},
 					},
 				},
@@ -165,7 +165,7 @@ func TestResourceGrantCreateMetastoreId(t *testing.T) {
 		HCL: `
 		metastore = "metastore_id"
 		principal = "me"
-		privileges = ["MODIFY"]
+		privileges = ["CREATE_CATALOG"]
 		`,
 	}.ApplyNoError(t)
 }
@@ -186,7 +186,7 @@ func TestFailsWhenWrongMetastoreId(t *testing.T) {
 		HCL: `
 		metastore = "new_id"
 		principal = "me"
-		privileges = ["MODIFY"]
+		privileges = ["CREATE_CATALOG"]
 		`,
 	}.ExpectError(t, "metastore_id must be empty or equal to the metastore id assigned to the workspace: old_id. "+
 		"If the metastore assigned to the workspace has changed, the new metastore id must be explicitly set")
@@ -889,3 +889,14 @@ func TestResourceGrantShareGrantCreateNoChange(t *testing.T) {
 		`,
 	}.ApplyNoError(t)
 }
+
+func TestResourceGrantInvalidPrivilege(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGrant(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+		principal = "me"
+		privileges = ["READ_VOLUMES"]`,
+	}.ExpectError(t, "privilege READ_VOLUMES is not applicable to catalog, did you mean READ_VOLUME?")
+}
@@ -82,15 +82,21 @@ func replaceAllPermissions(a permissions.UnityCatalogPermissionsAPI, securable s
 		return err
 	}
 	diff := diffPermissions(list.PrivilegeAssignments, existing.PrivilegeAssignments)
+	return applyPermissionsDiff(a, securableType, name, list.PrivilegeAssignments, diff)
+}
+
+// applyPermissionsDiff updates permissions of the securable and waits until they're equal to the desired ones
+func applyPermissionsDiff(a permissions.UnityCatalogPermissionsAPI, securableType catalog.SecurableType, name string,
+	desired []catalog.PrivilegeAssignment, diff []catalog.PermissionsChange) error {
 	if len(diff) == 0 {
 		// The permissions are already correct, no need to update or wait
 		return nil
 	}
-	err = a.UpdatePermissions(securableType, name, diff)
+	err := a.UpdatePermissions(securableType, name, diff)
 	if err != nil {
 		return err
 	}
-	return a.WaitForUpdate(1*time.Minute, securableType, name, list.PrivilegeAssignments, func(current []catalog.PrivilegeAssignment, desired []catalog.PrivilegeAssignment) []catalog.PermissionsChange {
+	return a.WaitForUpdate(1*time.Minute, securableType, name, desired, func(current []catalog.PrivilegeAssignment, desired []catalog.PrivilegeAssignment) []catalog.PermissionsChange {
 		return diffPermissions(desired, current)
 	})
 }
@@ -131,6 +137,16 @@ func parseId(d *schema.ResourceData) (string, string, error) {
 	return split[0], split[1], nil
 }
 
+// grantedSecurable returns the securable attribute set in the configuration, even if its value isn't known yet
+func grantedSecurable(d *schema.ResourceDiff) string {
+	for field := range permissions.Mappings {
+		if d.Get(field).(string) != "" || !d.NewValueKnown(field) {
+			return field
+		}
+	}
+	return ""
+}
+
 func ResourceGrants() common.Resource {
 	s := common.StructToSchema(PermissionsList{},
 		func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -166,6 +182,19 @@ func ResourceGrants() common.Resource {
 		})
 	return common.Resource{
 		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if !d.NewValueKnown("grant") {
+				return nil
+			}
+			securable := grantedSecurable(d)
+			for _, grant := range d.Get("grant").(*schema.Set).List() {
+				err := permissions.ValidatePrivileges(securable, grant.(map[string]any)["privileges"].(*schema.Set))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
 			if err != nil {
@@ -183,7 +183,7 @@ func TestErrorIfWrongMetastoreId(t *testing.T) {
 
 		grant {
 			principal = "me"
-			privileges = ["MODIFY"]
+			privileges = ["CREATE_CATALOG"]
 		}`,
 	}.ExpectError(t, "metastore_id must be empty or equal to the metastore id assigned to the workspace: old_id. "+
 		"If the metastore assigned to the workspace has changed, the new metastore id must be explicitly set")
@@ -739,3 +739,32 @@ func TestModelGrantCreate(t *testing.T) {
 		}`,
 	}.ApplyNoError(t)
 }
+
+func TestResourceGrantsInvalidPrivilege(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGrants(),
+		Create:   true,
+		HCL: `
+		table = "foo.bar.baz"
+
+		grant {
+			principal = "me"
+			privileges = ["USE_CATALOG"]
+		}`,
+	}.ExpectError(t, "privilege USE_CATALOG is not applicable to table. "+
+		"Applicable privileges are: ALL_PRIVILEGES, APPLY_TAG, MANAGE, MODIFY, REFRESH, SELECT")
+}
+
+func TestResourceGrantsLegacyPrivilege(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGrants(),
+		Create:   true,
+		HCL: `
+		catalog = "main"
+
+		grant {
+			principal = "me"
+			privileges = ["USAGE"]
+		}`,
+	}.ExpectError(t, "privilege USAGE is not applicable to catalog, use USE_CATALOG instead")
+}
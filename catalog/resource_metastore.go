@@ -87,7 +87,8 @@ func ResourceMetastore() common.Resource {
 		})
 
 	return common.Resource{
-		Schema: s,
+		Schema:             s,
+		DeletionProtection: true,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
 			return common.NamespaceCustomizeDiff(ctx, d, c)
 		},
@@ -2,7 +2,10 @@ package catalog
 
 import (
 	"context"
+	"iter"
+	"slices"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +26,16 @@ type SchemaInfo struct {
 	common.Namespace
 }
 
+// ListSchemas lists the schemas of a catalog that could be managed by databricks_schema, skipping
+// the information schema and the schemas of non-managed catalogs.
+func ListSchemas(ctx context.Context, w *databricks.WorkspaceClient, catalogName string) iter.Seq2[catalog.SchemaInfo, error] {
+	ignoredSchemas := []string{"information_schema"}
+	it := w.Schemas.List(ctx, catalog.ListSchemasRequest{CatalogName: catalogName})
+	return common.ListingSeq(ctx, it, func(schema catalog.SchemaInfo) bool {
+		return schema.CatalogType == catalog.CatalogTypeManagedCatalog && !slices.Contains(ignoredSchemas, schema.Name)
+	})
+}
+
 func ResourceSchema() common.Resource {
 	s := common.StructToSchema(SchemaInfo{},
 		func(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -161,5 +174,6 @@ func ResourceSchema() common.Resource {
 			}
 			return w.Schemas.Delete(ctx, catalog.DeleteSchemaRequest{FullName: name, Force: force})
 		},
+		WithIdentity: true,
 	}
 }
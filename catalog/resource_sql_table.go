@@ -4,25 +4,24 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"log"
 	"maps"
 	"reflect"
 	"regexp"
 	"slices"
 	"strings"
-	"time"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
-	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var MaxSqlExecWaitTimeout = 50
 var optionPrefixes = []string{"option.", "spark.sql.dataSourceOptions."}
 
 type SqlColumnInfo struct {
@@ -65,10 +64,13 @@ type SqlTableInfo struct {
 	WarehouseID         string            `json:"warehouse_id,omitempty"`
 	Owner               string            `json:"owner,omitempty" tf:"computed"`
 	TableID             string            `json:"table_id" tf:"computed"`
+	// PlannedStatements are the SQL statements that will be executed to update the table. Set during the plan
+	PlannedStatements []string `json:"planned_statements,omitempty" tf:"computed"`
+	// AllowDestructiveChanges must be set to execute statements that may lose data, like `DROP COLUMN`
+	AllowDestructiveChanges bool `json:"allow_destructive_changes,omitempty"`
 	common.Namespace
 
-	exec    common.CommandExecutor
-	sqlExec sql.StatementExecutionInterface
+	exec common.StatementExecutor
 }
 
 func (ti SqlTableInfo) CustomizeSchema(s *common.CustomizableSchema) *common.CustomizableSchema {
@@ -122,6 +124,59 @@ func (ti *SqlTableInfo) SQLFullName() string {
 	return fmt.Sprintf("`%s`.`%s`.`%s`", ti.CatalogName, ti.SchemaName, ti.Name)
 }
 
+// QuoteIdentifier wraps a single Unity Catalog identifier in backticks, escaping backticks inside of it.
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// ParseFullName splits a dot-separated Unity Catalog name into its parts. Parts may be quoted with
+// backticks, in which case they can contain dots and escaped (doubled) backticks.
+func ParseFullName(fullName string) ([]string, error) {
+	var parts []string
+	var current strings.Builder
+	quoted := false
+	wasQuoted := false
+	for i := 0; i < len(fullName); i++ {
+		c := fullName[i]
+		switch {
+		case quoted && c == '`':
+			if i+1 < len(fullName) && fullName[i+1] == '`' {
+				current.WriteByte('`')
+				i++
+				continue
+			}
+			quoted = false
+		case quoted:
+			current.WriteByte(c)
+		case c == '`':
+			if current.Len() > 0 || wasQuoted {
+				return nil, fmt.Errorf("unexpected backtick at position %d in %s", i, fullName)
+			}
+			quoted = true
+			wasQuoted = true
+		case c == '.':
+			if current.Len() == 0 && !wasQuoted {
+				return nil, fmt.Errorf("empty name part in %s", fullName)
+			}
+			parts = append(parts, current.String())
+			current.Reset()
+			wasQuoted = false
+		default:
+			if wasQuoted {
+				return nil, fmt.Errorf("unexpected character after closing backtick at position %d in %s", i, fullName)
+			}
+			current.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated backtick in %s", fullName)
+	}
+	if current.Len() == 0 && !wasQuoted {
+		return nil, fmt.Errorf("empty name part in %s", fullName)
+	}
+	return append(parts, current.String()), nil
+}
+
 func parseComment(s string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(s, `\'`, `'`), `'`, `\'`)
 }
@@ -149,12 +204,19 @@ func reconstructIdentity(c *SqlColumnInfo) (IdentityColumn, error) {
 }
 
 func (ti *SqlTableInfo) initCluster(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) (err error) {
-	defaultClusterName := "terraform-sql-table"
-	clustersAPI := clusters.NewClustersAPI(ctx, c)
-	// if a warehouse id is specified, use the warehouse
+	// if a warehouse id is specified, execute statements on the warehouse without starting any cluster
 	if wi, ok := d.GetOk("warehouse_id"); ok {
 		ti.WarehouseID = wi.(string)
-	} else if ci, ok := d.GetOk("cluster_id"); ok {
+		w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
+		if err != nil {
+			return err
+		}
+		ti.exec = c.WarehouseStatementExecutor(w, ti.WarehouseID, "")
+		return nil
+	}
+	defaultClusterName := "terraform-sql-table"
+	clustersAPI := clusters.NewClustersAPI(ctx, c)
+	if ci, ok := d.GetOk("cluster_id"); ok {
 		// if a cluster id is specified, start the cluster
 		ti.ClusterID = ci.(string)
 		_, err = clustersAPI.StartAndGetInfo(ti.ClusterID)
@@ -176,12 +238,7 @@ func (ti *SqlTableInfo) initCluster(ctx context.Context, d *schema.ResourceData,
 			return
 		}
 	}
-	ti.exec = c.CommandExecutor(ctx)
-	w, err := c.WorkspaceClientUnifiedProvider(ctx, d)
-	if err != nil {
-		return err
-	}
-	ti.sqlExec = w.StatementExecution
+	ti.exec = c.ClusterStatementExecutor(ctx, ti.ClusterID)
 	return nil
 }
 
@@ -487,13 +544,37 @@ func (ti *SqlTableInfo) formatViewDefinition() {
 	ti.ViewDefinition = strings.ReplaceAll(ti.ViewDefinition, "\t", "    ")
 }
 
-func (ti *SqlTableInfo) updateTable(oldti *SqlTableInfo) error {
+// isDestructiveStatement returns true for statements that may lose data of the table
+func (ti *SqlTableInfo) isDestructiveStatement(statement string) bool {
+	return strings.HasPrefix(statement, fmt.Sprintf("ALTER %s %s DROP COLUMN ", ti.getTableTypeString(), ti.SQLFullName()))
+}
+
+// checkDestructiveStatements returns an error if any of the statements is destructive and it wasn't explicitly
+// allowed with `allow_destructive_changes`
+func (ti *SqlTableInfo) checkDestructiveStatements(statements []string) error {
+	if ti.AllowDestructiveChanges {
+		return nil
+	}
+	destructive := slices.DeleteFunc(slices.Clone(statements), func(statement string) bool {
+		return !ti.isDestructiveStatement(statement)
+	})
+	if len(destructive) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the following statements may lose data of %s, set `allow_destructive_changes = true` to execute them: %s",
+		ti.SQLFullName(), strings.Join(destructive, "; "))
+}
+
+func (ti *SqlTableInfo) updateTable(ctx context.Context, oldti *SqlTableInfo) error {
 	statements, err := ti.diff(oldti)
 	if err != nil {
 		return err
 	}
+	if err = ti.checkDestructiveStatements(statements); err != nil {
+		return err
+	}
 	for _, statement := range statements {
-		err = ti.applySql(statement)
+		err = ti.applySql(ctx, statement)
 		if err != nil {
 			return err
 		}
@@ -501,39 +582,18 @@ func (ti *SqlTableInfo) updateTable(oldti *SqlTableInfo) error {
 	return nil
 }
 
-func (ti *SqlTableInfo) createTable() error {
-	return ti.applySql(ti.buildTableCreateStatement())
+func (ti *SqlTableInfo) createTable(ctx context.Context) error {
+	return ti.applySql(ctx, ti.buildTableCreateStatement())
 }
 
-func (ti *SqlTableInfo) deleteTable() error {
-	return ti.applySql(fmt.Sprintf("DROP %s %s", ti.getTableTypeString(), ti.SQLFullName()))
+func (ti *SqlTableInfo) deleteTable(ctx context.Context) error {
+	return ti.applySql(ctx, fmt.Sprintf("DROP %s %s", ti.getTableTypeString(), ti.SQLFullName()))
 }
 
-func (ti *SqlTableInfo) applySql(sqlQuery string) error {
+func (ti *SqlTableInfo) applySql(ctx context.Context, sqlQuery string) error {
 	log.Printf("[INFO] Executing Sql: %s", sqlQuery)
-	if ti.WarehouseID != "" {
-		execCtx, cancel := context.WithTimeout(context.Background(), time.Duration(MaxSqlExecWaitTimeout)*time.Second)
-		defer cancel()
-		sqlRes, err := ti.sqlExec.ExecuteStatement(execCtx, sql.ExecuteStatementRequest{
-			Statement:     sqlQuery,
-			WaitTimeout:   fmt.Sprintf("%ds", MaxSqlExecWaitTimeout), //max allowed by sql exec
-			WarehouseId:   ti.WarehouseID,
-			OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
-		})
-		if err != nil {
-			return err
-		}
-		if sqlRes.Status.State != "SUCCEEDED" {
-			return fmt.Errorf("statement failed to execute: %s", sqlRes.Status.State)
-		}
-		return nil
-	}
-
-	r := ti.exec.Execute(ti.ClusterID, "sql", sqlQuery)
-	if r.Failed() {
-		return fmt.Errorf("cannot execute %s: %s", sqlQuery, r.Error())
-	}
-	return nil
+	_, err := ti.exec.Execute(ctx, sqlQuery)
+	return err
 }
 
 func columnChangesCustomizeDiff(d *schema.ResourceDiff, newTable *SqlTableInfo) error {
@@ -556,6 +616,74 @@ func columnChangesCustomizeDiff(d *schema.ResourceDiff, newTable *SqlTableInfo)
 	return nil
 }
 
+// priorStateGetter reads values from the prior state of the resource diff, so common.DiffToStructPointer
+// could be used to get the table definition before the change
+type priorStateGetter struct {
+	d *schema.ResourceDiff
+}
+
+func (p priorStateGetter) GetOk(key string) (any, bool) {
+	old, _ := p.d.GetChange(key)
+	if old == nil {
+		return nil, false
+	}
+	if set, ok := old.(*schema.Set); ok {
+		return old, set.Len() > 0
+	}
+	rv := reflect.ValueOf(old)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return old, rv.Len() > 0
+	}
+	return old, !rv.IsZero()
+}
+
+func (p priorStateGetter) GetOkExists(key string) (any, bool) {
+	old, _ := p.d.GetChange(key)
+	return old, old != nil
+}
+
+// plannedStatementsFields are the fields that are changed with SQL statements during the update
+var plannedStatementsFields = []string{"storage_location", "cluster_keys", "comment", "properties", "column", "view_definition"}
+
+// plannedStatementsCustomizeDiff sets the `planned_statements` attribute to the SQL statements that will be executed
+// during the update, and checks that destructive statements are explicitly allowed
+func plannedStatementsCustomizeDiff(d *schema.ResourceDiff, tableSchema map[string]*schema.Schema) error {
+	if d.Id() == "" {
+		// statements are planned only for updates
+		return d.Clear("planned_statements")
+	}
+	for k, v := range tableSchema {
+		if v.ForceNew && d.HasChange(k) {
+			// the table will be re-created
+			return nil
+		}
+	}
+	if d.HasChange("comment") && d.Get("table_type") == "VIEW" {
+		return nil
+	}
+	for _, k := range plannedStatementsFields {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("planned_statements")
+		}
+	}
+	var oldti, newti SqlTableInfo
+	common.DiffToStructPointer(priorStateGetter{d}, tableSchema, &oldti)
+	common.DiffToStructPointer(d, tableSchema, &newti)
+	statements, err := newti.diff(&oldti)
+	if err != nil {
+		return err
+	}
+	if len(statements) == 0 {
+		// avoid showing `planned_statements` as a change when nothing will be executed
+		return d.Clear("planned_statements")
+	}
+	if err = newti.checkDestructiveStatements(statements); err != nil {
+		return err
+	}
+	return d.SetNew("planned_statements", statements)
+}
+
 var columnTypeAliases = map[string]string{
 	"integer": "int",
 	"long":    "bigint",
@@ -612,10 +740,31 @@ func assertNoColumnMembershipAndFieldValueUpdate(oldCols []interface{}, newColum
 	return nil
 }
 
+// IsSqlTableType returns true for the table types that are managed by databricks_sql_table.
+func IsSqlTableType(tableType catalog.TableType) bool {
+	switch tableType {
+	case catalog.TableTypeManaged, catalog.TableTypeExternal, catalog.TableTypeView:
+		return true
+	}
+	return false
+}
+
+// ListSqlTables lists the tables and views of a schema that could be managed by databricks_sql_table.
+func ListSqlTables(ctx context.Context, w *databricks.WorkspaceClient, catalogName, schemaName string) iter.Seq2[catalog.TableInfo, error] {
+	it := w.Tables.List(ctx, catalog.ListTablesRequest{
+		CatalogName: catalogName,
+		SchemaName:  schemaName,
+	})
+	return common.ListingSeq(ctx, it, func(table catalog.TableInfo) bool {
+		return IsSqlTableType(table.TableType)
+	})
+}
+
 func ResourceSqlTable() common.Resource {
 	tableSchema := common.StructToSchema(SqlTableInfo{}, nil)
 	return common.Resource{
-		Schema: tableSchema,
+		Schema:             tableSchema,
+		DeletionProtection: true,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
 			if d.HasChange("column") {
 				var newTableStruct SqlTableInfo
@@ -668,7 +817,7 @@ func ResourceSqlTable() common.Resource {
 			if d.HasChange("comment") && d.Get("table_type") == "VIEW" {
 				d.ForceNew("comment")
 			}
-			return nil
+			return plannedStatementsCustomizeDiff(d, tableSchema)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var ti = new(SqlTableInfo)
@@ -676,7 +825,7 @@ func ResourceSqlTable() common.Resource {
 			if err := ti.initCluster(ctx, d, c); err != nil {
 				return err
 			}
-			if err := ti.createTable(); err != nil {
+			if err := ti.createTable(ctx); err != nil {
 				return err
 			}
 			if ti.Owner != "" {
@@ -731,6 +880,8 @@ func ResourceSqlTable() common.Resource {
 			}
 
 			d.Set("partitions", partitions)
+			// statements are planned only for the next update
+			d.Set("planned_statements", []string{})
 			return common.StructToData(ti, tableSchema, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -747,7 +898,7 @@ func ResourceSqlTable() common.Resource {
 			if err != nil {
 				return err
 			}
-			err = newti.updateTable(&oldti)
+			err = newti.updateTable(ctx, &oldti)
 			if err != nil {
 				return err
 			}
@@ -773,7 +924,8 @@ func ResourceSqlTable() common.Resource {
 			if err := ti.initCluster(ctx, d, c); err != nil {
 				return err
 			}
-			return ti.deleteTable()
+			return ti.deleteTable(ctx)
 		},
+		WithIdentity: true,
 	}
 }
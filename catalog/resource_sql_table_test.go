@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/sql"
@@ -14,6 +15,7 @@ import (
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 )
 
@@ -971,10 +973,11 @@ func getColumnsInstanceState(columns []SqlColumnInfo) map[string]string {
 }
 
 type resourceSqlTableUpdateColumnTestMetaData struct {
-	oldColumns       []SqlColumnInfo
-	newColumns       []SqlColumnInfo
-	allowedCommands  []string
-	expectedErrorMsg string
+	oldColumns              []SqlColumnInfo
+	newColumns              []SqlColumnInfo
+	allowDestructiveChanges bool
+	allowedCommands         []string
+	expectedErrorMsg        string
 }
 
 func resourceSqlTableUpdateColumnHelper(t *testing.T, testMetaData resourceSqlTableUpdateColumnTestMetaData) {
@@ -1009,8 +1012,9 @@ func resourceSqlTableUpdateColumnHelper(t *testing.T, testMetaData resourceSqlTa
 		storage_location   = "s3://ext-main/foo/bar1"
 		comment 		   = "terraform managed"
 		cluster_id         = "gone"
+		allow_destructive_changes = %t
 		%s
-		`, newColumnsTemplate),
+		`, testMetaData.allowDestructiveChanges, newColumnsTemplate),
 		InstanceState: instanceStateMap,
 		Fixtures: append([]qa.HTTPFixture{
 			{
@@ -1312,6 +1316,7 @@ func TestResourceSqlTableUpdateTable_DropColumn(t *testing.T) {
 					Nullable: true,
 				},
 			},
+			allowDestructiveChanges: true,
 			allowedCommands: []string{
 				"ALTER TABLE `main`.`foo`.`bar` DROP COLUMN IF EXISTS (`two`)",
 			},
@@ -1349,6 +1354,7 @@ func TestResourceSqlTableUpdateTable_DropMultipleColumns(t *testing.T) {
 					Nullable: true,
 				},
 			},
+			allowDestructiveChanges: true,
 			allowedCommands: []string{
 				// Ordering might not be preserved.
 				"ALTER TABLE `main`.`foo`.`bar` DROP COLUMN IF EXISTS (`two`, `three`)",
@@ -1359,6 +1365,119 @@ func TestResourceSqlTableUpdateTable_DropMultipleColumns(t *testing.T) {
 	)
 }
 
+func TestResourceSqlTableUpdateTable_DropColumnNotAllowed(t *testing.T) {
+	resourceSqlTableUpdateColumnHelper(t,
+		resourceSqlTableUpdateColumnTestMetaData{
+			oldColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "string",
+					Nullable: true,
+				},
+				{
+					Name:     "two", // will be dropped
+					Type:     "string",
+					Nullable: true,
+				},
+			},
+			newColumns: []SqlColumnInfo{
+				{
+					Name:     "one",
+					Type:     "string",
+					Nullable: true,
+				},
+			},
+			allowedCommands: []string{},
+			expectedErrorMsg: "the following statements may lose data of `main`.`foo`.`bar`, set " +
+				"`allow_destructive_changes = true` to execute them: " +
+				"ALTER TABLE `main`.`foo`.`bar` DROP COLUMN IF EXISTS (`two`)",
+		},
+	)
+}
+
+func sqlTablePlannedStatementsDiff(config map[string]any) (*terraform.InstanceDiff, error) {
+	state := &terraform.InstanceState{
+		ID: "main.foo.bar",
+		Attributes: map[string]string{
+			"catalog_name":         "main",
+			"schema_name":          "foo",
+			"table_type":           "MANAGED",
+			"name":                 "bar",
+			"comment":              "old comment",
+			"column.#":             "2",
+			"column.0.name":        "one",
+			"column.0.type":        "string",
+			"column.0.nullable":    "true",
+			"column.1.name":        "two",
+			"column.1.type":        "string",
+			"column.1.nullable":    "true",
+			"partitions.#":         "0",
+			"planned_statements.#": "0",
+		},
+	}
+	for k, v := range map[string]any{
+		"catalog_name": "main",
+		"schema_name":  "foo",
+		"table_type":   "MANAGED",
+		"name":         "bar",
+	} {
+		config[k] = v
+	}
+	return ResourceSqlTable().ToResource().Diff(context.Background(), state,
+		terraform.NewResourceConfigRaw(config), &common.DatabricksClient{})
+}
+
+func TestResourceSqlTable_Diff_PlannedStatements(t *testing.T) {
+	diff, err := sqlTablePlannedStatementsDiff(map[string]any{
+		"comment":      "new comment",
+		"cluster_keys": []any{"one"},
+		"column": []any{
+			map[string]any{"name": "one", "type": "string"},
+			map[string]any{"name": "two", "type": "string"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2", diff.Attributes["planned_statements.#"].New)
+	assert.Equal(t, "ALTER TABLE `main`.`foo`.`bar` CLUSTER BY (`one`)", diff.Attributes["planned_statements.0"].New)
+	assert.Equal(t, "COMMENT ON TABLE `main`.`foo`.`bar` IS 'new comment'", diff.Attributes["planned_statements.1"].New)
+}
+
+func TestResourceSqlTable_Diff_NoPlannedStatements(t *testing.T) {
+	diff, err := sqlTablePlannedStatementsDiff(map[string]any{
+		"comment": "old comment",
+		"owner":   "new_owner",
+		"column": []any{
+			map[string]any{"name": "one", "type": "string"},
+			map[string]any{"name": "two", "type": "string"},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, diff.Attributes, "planned_statements.#")
+	assert.Equal(t, "new_owner", diff.Attributes["owner"].New)
+}
+
+func TestResourceSqlTable_Diff_DestructiveStatements(t *testing.T) {
+	config := func() map[string]any {
+		return map[string]any{
+			"comment": "old comment",
+			"column": []any{
+				map[string]any{"name": "one", "type": "string"},
+			},
+		}
+	}
+	_, err := sqlTablePlannedStatementsDiff(config())
+	assert.EqualError(t, err, "the following statements may lose data of `main`.`foo`.`bar`, set "+
+		"`allow_destructive_changes = true` to execute them: "+
+		"ALTER TABLE `main`.`foo`.`bar` DROP COLUMN IF EXISTS (`two`)")
+
+	allowed := config()
+	allowed["allow_destructive_changes"] = true
+	diff, err := sqlTablePlannedStatementsDiff(allowed)
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE `main`.`foo`.`bar` DROP COLUMN IF EXISTS (`two`)",
+		diff.Attributes["planned_statements.0"].New)
+}
+
 func TestResourceSqlTableCreateTable_ExistingSQLWarehouse(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
@@ -1395,7 +1514,7 @@ func TestResourceSqlTableCreateTable_ExistingSQLWarehouse(t *testing.T) {
 					Statement:     "CREATE OR REPLACE TABLE `main`.`foo`.`bar` (`id` int, `name` string COMMENT 'name of thing')\nUSING DELTA\nCOMMENT 'this table is managed by terraform'\nLOCATION 'abfss://container@account/somepath';",
 					WaitTimeout:   "50s",
 					WarehouseId:   "existingwarehouse",
-					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
 				},
 				Response: sql.StatementResponse{
 					StatementId: "statement1",
@@ -1476,7 +1595,7 @@ func TestResourceSqlTableCreateTableWithIdentityColumn_ExistingSQLWarehouse(t *t
 					Statement:     "CREATE OR REPLACE TABLE `main`.`foo`.`bar` (`id` bigint GENERATED BY DEFAULT AS IDENTITY, `name` string COMMENT 'name of thing', `number` bigint GENERATED ALWAYS AS IDENTITY)\nUSING DELTA\nCOMMENT 'this table is managed by terraform'\nLOCATION 'abfss://container@account/somepath';",
 					WaitTimeout:   "50s",
 					WarehouseId:   "existingwarehouse",
-					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
 				},
 				Response: sql.StatementResponse{
 					StatementId: "statement1",
@@ -1696,6 +1815,106 @@ func TestResourceSqlTableReadTableWithPartitionColumn_ExistingSQLWarehouse(t *te
 	})
 }
 
+func TestResourceSqlTableUpdateTable_ExistingSQLWarehouse(t *testing.T) {
+	statements := []string{}
+	qa.ResourceFixture{
+		StatementMock: func(statement string) ([][]string, error) {
+			statements = append(statements, statement)
+			return nil, nil
+		},
+		HCL: `
+		name               = "bar"
+		catalog_name       = "main"
+		schema_name        = "foo"
+		table_type         = "EXTERNAL"
+		data_source_format = "DELTA"
+		storage_location   = "s3://ext-main/foo/bar1"
+		comment            = "this table is managed by terraform"
+		warehouse_id       = "existingwarehouse"
+		column {
+			name      = "one"
+			type      = "string"
+			comment   = "managed comment"
+		}
+		`,
+		InstanceState: map[string]string{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "EXTERNAL",
+			"data_source_format": "DELTA",
+			"storage_location":   "s3://ext-main/foo/bar1",
+			"comment":            "terraform managed",
+			"warehouse_id":       "existingwarehouse",
+			"column.#":           "1",
+			"column.0.name":      "one",
+			"column.0.type":      "string",
+			"column.0.comment":   "old comment",
+			"column.0.nullable":  "true",
+		},
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.1/unity-catalog/tables/main.foo.bar",
+				ReuseRequest: true,
+				Response: SqlTableInfo{
+					Name:             "bar",
+					CatalogName:      "main",
+					SchemaName:       "foo",
+					TableType:        "EXTERNAL",
+					DataSourceFormat: "DELTA",
+					StorageLocation:  "s3://ext-main/foo/bar1",
+					Comment:          "terraform managed",
+					ColumnInfos: []SqlColumnInfo{
+						{
+							Name:     "one",
+							Type:     "string",
+							Comment:  "old comment",
+							Nullable: true,
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/unity-catalog/tables/main.foo.bar?",
+				Response: catalog.TableInfo{},
+			},
+		},
+		Resource: ResourceSqlTable(),
+		ID:       "main.foo.bar",
+		Update:   true,
+	}.ApplyNoError(t)
+	assert.Equal(t, []string{
+		"COMMENT ON TABLE `main`.`foo`.`bar` IS 'this table is managed by terraform'",
+		"ALTER TABLE `main`.`foo`.`bar` ALTER COLUMN `one` COMMENT 'managed comment'",
+	}, statements)
+}
+
+func TestResourceSqlTableDeleteTable_ExistingSQLWarehouseError(t *testing.T) {
+	qa.ResourceFixture{
+		StatementMock: func(statement string) ([][]string, error) {
+			return nil, &apierr.APIError{
+				ErrorCode:  "PERMISSION_DENIED",
+				StatusCode: 403,
+				Message:    "cannot execute " + statement + ": User does not have MANAGE on Table",
+			}
+		},
+		Resource: ResourceSqlTable(),
+		State: map[string]any{
+			"name":               "bar",
+			"catalog_name":       "main",
+			"schema_name":        "foo",
+			"table_type":         "EXTERNAL",
+			"data_source_format": "DELTA",
+			"storage_location":   "s3://ext-main/foo/bar1",
+			"warehouse_id":       "existingwarehouse",
+		},
+		Delete: true,
+		ID:     "main.foo.bar",
+	}.ExpectError(t, "cannot execute DROP TABLE `main`.`foo`.`bar`: User does not have MANAGE on Table")
+}
+
 func TestResourceSqlTableCreateTable_OnlyManagedProperties(t *testing.T) {
 	qa.ResourceFixture{
 		CommandMock: func(commandStr string) common.CommandResults {
@@ -1728,7 +1947,7 @@ func TestResourceSqlTableCreateTable_OnlyManagedProperties(t *testing.T) {
 					Statement:     "CREATE OR REPLACE TABLE `main`.`foo`.`bar` (`id` int)\nUSING DELTA\nTBLPROPERTIES ('delta.enableDeletionVectors'='false');",
 					WaitTimeout:   "50s",
 					WarehouseId:   "existingwarehouse",
-					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutCancel,
+					OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
 				},
 				Response: sql.StatementResponse{
 					StatementId: "statement1",
@@ -2100,3 +2319,28 @@ func TestFormatViewDefinition(t *testing.T) {
 		t.Errorf("Expected view definition: %s, but got: %s", expected, ti.ViewDefinition)
 	}
 }
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "`abc`", QuoteIdentifier("abc"))
+	assert.Equal(t, "`a.b`", QuoteIdentifier("a.b"))
+	assert.Equal(t, "`a``b`", QuoteIdentifier("a`b"))
+}
+
+func TestParseFullName(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"main":                   {"main"},
+		"main.default":           {"main", "default"},
+		"main.default.table":     {"main", "default", "table"},
+		"`main`.`default`.`t.1`": {"main", "default", "t.1"},
+		"`a``b`.c":               {"a`b", "c"},
+		"``.c":                   {"", "c"},
+	} {
+		actual, err := ParseFullName(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, actual, input)
+	}
+	for _, input := range []string{"", "main.", ".main", "main..table", "`main", "`main`x.y", "ma`in`.y"} {
+		_, err := ParseFullName(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,10 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/databricks/databricks-sdk-go"
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/libraries"
+	"github.com/databricks/terraform-provider-databricks/policies"
 )
 
 const DefaultProvisionTimeout = 30 * time.Minute
@@ -32,6 +37,9 @@ const (
 func ResourceCluster() common.Resource {
 	return common.Resource{
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			if err := validateClusterPolicy(ctx, d, c); err != nil {
+				return err
+			}
 			return common.NamespaceCustomizeDiff(ctx, d, c)
 		},
 		Create:        resourceClusterCreate,
@@ -48,7 +56,38 @@ func ResourceCluster() common.Resource {
 				Upgrade: removeZeroAwsEbsVolumeAttributes,
 			},
 		},
+		WithIdentity: true,
+	}
+}
+
+// validateClusterPolicy checks the planned cluster against the rules of its cluster policy, so violations are
+// reported during the plan instead of failing in the middle of apply
+func validateClusterPolicy(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+	policyId := d.Get("policy_id").(string)
+	if policyId == "" || !d.NewValueKnown("policy_id") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges(slices.Collect(maps.Keys(clusterSchema))...) {
+		return nil
 	}
+	w, err := c.WorkspaceClientUnifiedProviderForDiff(ctx, d)
+	if err != nil {
+		return err
+	}
+	var cluster compute.ClusterSpec
+	common.DiffToStructPointer(d, clusterSchema, &cluster)
+	return policies.NewClusterSpecValidator(w).Validate(ctx, policyId, policies.ClusterTypeAllPurpose, cluster, "")
+}
+
+// ListInteractiveClusters lists clusters created from the UI or through the API, i.e. the clusters that
+// could be managed by databricks_cluster, as opposed to job and pipeline clusters.
+func ListInteractiveClusters(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[compute.ClusterDetails, error] {
+	return common.ListingSeq(ctx, w.Clusters.List(ctx, compute.ListClustersRequest{
+		FilterBy: &compute.ListClustersFilterBy{
+			ClusterSources: []compute.ClusterSource{compute.ClusterSourceUi, compute.ClusterSourceApi},
+		},
+		PageSize: 100,
+	}), nil)
 }
 
 func clusterSchemaV0() cty.Type {
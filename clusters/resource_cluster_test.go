@@ -2085,3 +2085,34 @@ func TestResourceClusterAliasAutoNoDrift_DataSecurityMode(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, d.HasChanges("data_security_mode"))
 }
+
+func TestResourceClusterCreate_PolicyViolations(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: compute.Policy{
+					PolicyId: "abc",
+					Name:     "Small clusters",
+					Definition: `{"autoscale.max_workers": {"type": "range", "maxValue": 10},
+					"autotermination_minutes": {"type": "fixed", "value": 30},
+					"cluster_type": {"type": "fixed", "value": "all-purpose"}}`,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "15.4.x-scala2.12"
+		node_type_id = "i3.xlarge"
+		policy_id = "abc"
+		autoscale {
+			min_workers = 1
+			max_workers = 20
+		}`,
+	}.ExpectError(t, "cluster doesn't satisfy the policy 'Small clusters' (abc):\n"+
+		"autoscale.max_workers: value 20 is greater than the maximum 10\n"+
+		"autotermination_minutes: value \"60\" must be equal to the fixed value \"30\"")
+}
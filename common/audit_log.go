@@ -0,0 +1,176 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditLogFileEnv is the environment variable with the path of the audit log, used if it's not configured
+// in the provider block
+const AuditLogFileEnv = "DATABRICKS_AUDIT_LOG_FILE"
+
+// redactedValue replaces values of sensitive fields in the audit log
+const redactedValue = "**REDACTED**"
+
+// AuditInfo describes the resource operation that makes API requests
+type AuditInfo struct {
+	ResourceType string
+	ResourceID   string
+	Phase        string
+
+	// SensitiveFields are names of sensitive fields of the resource, which values are redacted from
+	// request bodies
+	SensitiveFields map[string]bool
+}
+
+type auditInfoKey struct{}
+
+// WithAuditInfo returns context, where API requests are recorded in the audit log with given info
+func WithAuditInfo(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+// AuditInfoFromContext returns info about the resource operation that makes API requests with the context
+func AuditInfoFromContext(ctx context.Context) (AuditInfo, bool) {
+	info, ok := ctx.Value(auditInfoKey{}).(AuditInfo)
+	return info, ok
+}
+
+// AuditEntry is a single line of the audit log
+type AuditEntry struct {
+	Timestamp    string `json:"timestamp"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	Phase        string `json:"phase,omitempty"`
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Status       int    `json:"status,omitempty"`
+	RequestID    string `json:"request_id,omitempty"`
+	Error        string `json:"error,omitempty"`
+	Body         any    `json:"body,omitempty"`
+}
+
+// auditLog writes entries of the audit log as JSON lines
+type auditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *auditLog) write(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.w.Write(append(line, '\n'))
+	return err
+}
+
+var (
+	auditLogsMu sync.Mutex
+	// auditLogs are shared by SDKv2 and Plugin Framework providers running in the same process
+	auditLogs = map[string]*auditLog{}
+)
+
+// AuditLogTransport wraps the given HTTP transport, so every REST API request that could change anything
+// in Databricks is appended to the audit log file at the given path
+func AuditLogTransport(path string, next http.RoundTripper) (http.RoundTripper, error) {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	log, ok := auditLogs[path]
+	if !ok {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open audit log: %w", err)
+		}
+		log = &auditLog{w: f}
+		auditLogs[path] = log
+	}
+	return auditLogTransport{log: log, next: next}, nil
+}
+
+type auditLogTransport struct {
+	log  *auditLog
+	next http.RoundTripper
+}
+
+func (t auditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadOnlyRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+	entry := AuditEntry{
+		Method: req.Method,
+		Path:   req.URL.Path,
+	}
+	info, ok := AuditInfoFromContext(req.Context())
+	if ok {
+		entry.ResourceType = info.ResourceType
+		entry.ResourceID = info.ResourceID
+		entry.Phase = info.Phase
+		// request bodies are logged only for resources, where sensitive fields are known
+		entry.Body = redactedBody(req, info.SensitiveFields)
+	}
+	resp, err := t.next.RoundTrip(req)
+	entry.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+		entry.RequestID = resp.Header.Get("X-Request-Id")
+	}
+	if logErr := t.log.write(entry); logErr != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, fmt.Errorf("cannot write audit log: %w", logErr)
+	}
+	return resp, err
+}
+
+// redactedBody returns JSON body of the request with values of sensitive fields replaced
+func redactedBody(req *http.Request, sensitiveFields map[string]bool) any {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	raw, err := io.ReadAll(body)
+	if err != nil || len(bytes.TrimSpace(raw)) == 0 {
+		return nil
+	}
+	var parsed any
+	if json.Unmarshal(raw, &parsed) != nil {
+		// non-JSON bodies, like uploaded files, are not logged
+		return redactedValue
+	}
+	return redact(parsed, sensitiveFields)
+}
+
+func redact(v any, sensitiveFields map[string]bool) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for k, nested := range value {
+			if sensitiveFields[k] {
+				value[k] = redactedValue
+				continue
+			}
+			value[k] = redact(nested, sensitiveFields)
+		}
+	case []any:
+		for i, nested := range value {
+			value[i] = redact(nested, sensitiveFields)
+		}
+	}
+	return v
+}
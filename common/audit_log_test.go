@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(200)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	transport, err := AuditLogTransport(auditLogPath, http.DefaultTransport)
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	// read-only requests are not recorded
+	resp, err := client.Get(server.URL + "/api/2.0/secrets/scopes/list")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx := WithAuditInfo(context.Background(), AuditInfo{
+		ResourceType:    "databricks_secret",
+		ResourceID:      "a|||b",
+		Phase:           "create",
+		SensitiveFields: map[string]bool{"string_value": true},
+	})
+	req, err := http.NewRequestWithContext(ctx, "POST", server.URL+"/api/2.0/secrets/put",
+		strings.NewReader(`{"scope": "a", "key": "b", "string_value": "secret", "nested": [{"string_value": "x"}]}`))
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// bodies of requests made outside of resources are not recorded
+	resp, err = client.Post(server.URL+"/api/2.0/token/create", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	raw, err := os.ReadFile(auditLogPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2)
+	assert.Regexp(t, `^\{"timestamp":"[^"]+","resource_type":"databricks_secret","resource_id":"a\|\|\|b",`+
+		`"phase":"create","method":"POST","path":"/api/2.0/secrets/put","status":200,"request_id":"req-1",`+
+		`"body":\{"key":"b","nested":\[\{"string_value":"\*\*REDACTED\*\*"\}\],"scope":"a",`+
+		`"string_value":"\*\*REDACTED\*\*"\}\}$`, lines[0])
+	assert.Regexp(t, `^\{"timestamp":"[^"]+","method":"POST","path":"/api/2.0/token/create","status":200,`+
+		`"request_id":"req-1"\}$`, lines[1])
+}
+
+func TestAuditLogTransport_Error(t *testing.T) {
+	auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	transport, err := AuditLogTransport(auditLogPath, http.DefaultTransport)
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Post("http://127.0.0.1:0/api/2.0/clusters/delete", "", nil)
+	require.Error(t, err)
+
+	raw, err := os.ReadFile(auditLogPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), `"method":"POST","path":"/api/2.0/clusters/delete","error":`)
+}
+
+func TestAuditLogTransport_CannotOpen(t *testing.T) {
+	_, err := AuditLogTransport(filepath.Join(t.TempDir(), "missing", "audit.jsonl"), http.DefaultTransport)
+	assert.ErrorContains(t, err, "cannot open audit log: ")
+}
@@ -57,6 +57,12 @@ type DatabricksClient struct {
 	// callback used to create API1.2 call wrapper, which simplifies unit testing
 	commandFactory func(context.Context, *DatabricksClient) CommandExecutor
 
+	// callback used to create SQL statement executor, which simplifies unit testing
+	statementFactory func(*databricks.WorkspaceClient, string, string) StatementExecutor
+
+	// readOnly is true if the provider must not create, update or delete resources
+	readOnly bool
+
 	// cachedWorkspaceClient is a cached workspace client authenticated to the workspace
 	// configured for the provider
 	cachedWorkspaceClient *databricks.WorkspaceClient
@@ -622,6 +628,8 @@ func (c *DatabricksClient) ClientForHost(ctx context.Context, url string) (*Data
 	return &DatabricksClient{
 		DatabricksClient: client,
 		commandFactory:   c.commandFactory,
+		statementFactory: c.statementFactory,
+		readOnly:         c.readOnly,
 	}, nil
 }
 
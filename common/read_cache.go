@@ -0,0 +1,152 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/databricks/databricks-sdk-go/logger"
+)
+
+type readCacheKey struct{}
+
+// WithReadCache returns context, where responses of successful GET requests are cached for the lifetime of
+// the provider process, if the provider is configured with read_cache = true. It must be used only for reads,
+// that don't wait for changes made in Databricks, because cached responses never change until the same API
+// path is changed by the provider.
+func WithReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheKey{}, true)
+}
+
+func readCacheEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(readCacheKey{}).(bool)
+	return enabled
+}
+
+type cachedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// ReadCache keeps responses of identical GET requests, keyed by host, path and query of the request
+type ReadCache struct {
+	mu      sync.Mutex
+	entries map[string]map[string]*cachedResponse
+
+	// generation is incremented on every invalidation, so responses of requests that were in flight
+	// during a change are not cached
+	generation uint64
+}
+
+// sharedReadCache is shared by SDKv2 and Plugin Framework providers running in the same process, so changes
+// made by resources of one provider invalidate responses cached by another
+var sharedReadCache = newReadCache()
+
+func newReadCache() *ReadCache {
+	return &ReadCache{entries: map[string]map[string]*cachedResponse{}}
+}
+
+func (c *ReadCache) get(path, key string) (*cachedResponse, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.entries[path][key]
+	return cached, c.generation, ok
+}
+
+func (c *ReadCache) put(path, key string, cached *cachedResponse, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	if c.entries[path] == nil {
+		c.entries[path] = map[string]*cachedResponse{}
+	}
+	c.entries[path][key] = cached
+}
+
+// invalidate removes cached responses for the given path, for paths nested in it and for paths it's nested in,
+// e.g. a change of /api/2.0/preview/scim/v2/Groups/123 invalidates both the group and the list of groups
+func (c *ReadCache) invalidate(host, path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	changed := host + strings.TrimSuffix(path, "/")
+	for cachedPath := range c.entries {
+		if cachedPath == changed ||
+			strings.HasPrefix(cachedPath, changed+"/") ||
+			strings.HasPrefix(changed, cachedPath+"/") {
+			delete(c.entries, cachedPath)
+		}
+	}
+}
+
+// Transport wraps the given HTTP transport, so it returns cached responses for GET requests made with
+// the context from WithReadCache, and invalidates them on requests that could change anything in Databricks
+func (c *ReadCache) Transport(next http.RoundTripper) http.RoundTripper {
+	return readCacheTransport{cache: c, next: next}
+}
+
+// ReadCacheTransport wraps the given HTTP transport with the read cache shared by all clients of the process
+func ReadCacheTransport(next http.RoundTripper) http.RoundTripper {
+	return sharedReadCache.Transport(next)
+}
+
+type readCacheTransport struct {
+	cache *ReadCache
+	next  http.RoundTripper
+}
+
+func (t readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		// responses read concurrently with the request could already be outdated, so they're invalidated twice
+		t.cache.invalidate(req.URL.Host, req.URL.Path)
+		defer t.cache.invalidate(req.URL.Host, req.URL.Path)
+		return t.next.RoundTrip(req)
+	}
+	if req.Method != http.MethodGet || !readCacheEnabled(req.Context()) {
+		return t.next.RoundTrip(req)
+	}
+	path := req.URL.Host + strings.TrimSuffix(req.URL.Path, "/")
+	key := req.URL.RawQuery
+	cached, generation, ok := t.cache.get(path, key)
+	if ok {
+		logger.Debugf(req.Context(), "read cache hit: GET %s", req.URL.RequestURI())
+		return cached.response(req), nil
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	cached = &cachedResponse{
+		status: resp.StatusCode,
+		header: resp.Header.Clone(),
+		body:   body,
+	}
+	t.cache.put(path, key, cached, generation)
+	return cached.response(req), nil
+}
+
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.status, http.StatusText(r.status)),
+		StatusCode:    r.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCacheTransport(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.RequestURI()]++
+		if r.URL.Path == "/api/2.0/missing" {
+			w.WriteHeader(404)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"path": "` + r.URL.Path + `"}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newReadCache().Transport(http.DefaultTransport)}
+	do := func(ctx context.Context, method, uri string) (int, string) {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+uri, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+	cached := WithReadCache(context.Background())
+
+	for i := 0; i < 3; i++ {
+		status, body := do(cached, "GET", "/api/2.0/preview/scim/v2/Groups/123?attributes=members")
+		assert.Equal(t, 200, status)
+		assert.Equal(t, `{"path": "/api/2.0/preview/scim/v2/Groups/123"}`, body)
+		do(cached, "GET", "/api/2.0/preview/scim/v2/Groups?filter=a")
+		do(cached, "GET", "/api/2.0/permissions/clusters/abc")
+		do(cached, "GET", "/api/2.0/missing")
+		do(context.Background(), "GET", "/api/2.0/permissions/jobs/123")
+	}
+	assert.Equal(t, map[string]int{
+		"GET /api/2.0/preview/scim/v2/Groups/123?attributes=members": 1,
+		"GET /api/2.0/preview/scim/v2/Groups?filter=a":               1,
+		"GET /api/2.0/permissions/clusters/abc":                      1,
+		"GET /api/2.0/missing":                                       3,
+		"GET /api/2.0/permissions/jobs/123":                          3,
+	}, requests)
+
+	// a change of the group invalidates the group and the list of groups, but not other paths
+	do(context.Background(), "PATCH", "/api/2.0/preview/scim/v2/Groups/123")
+	do(cached, "GET", "/api/2.0/preview/scim/v2/Groups/123?attributes=members")
+	do(cached, "GET", "/api/2.0/preview/scim/v2/Groups?filter=a")
+	do(cached, "GET", "/api/2.0/permissions/clusters/abc")
+	assert.Equal(t, 2, requests["GET /api/2.0/preview/scim/v2/Groups/123?attributes=members"])
+	assert.Equal(t, 2, requests["GET /api/2.0/preview/scim/v2/Groups?filter=a"])
+	assert.Equal(t, 1, requests["GET /api/2.0/permissions/clusters/abc"])
+
+	do(context.Background(), "PUT", "/api/2.0/permissions/clusters/abc")
+	do(cached, "GET", "/api/2.0/permissions/clusters/abc")
+	assert.Equal(t, 2, requests["GET /api/2.0/permissions/clusters/abc"])
+}
+
+func TestReadCacheSkipsResponsesOfRequestsInFlightDuringChange(t *testing.T) {
+	cache := newReadCache()
+	cache.put("host/api/2.0/a", "", &cachedResponse{}, 0)
+	_, generation, ok := cache.get("host/api/2.0/b", "")
+	assert.False(t, ok)
+
+	cache.invalidate("host", "/api/2.0/c")
+	_, _, ok = cache.get("host/api/2.0/a", "")
+	assert.True(t, ok)
+
+	cache.put("host/api/2.0/b", "", &cachedResponse{}, generation)
+	_, _, ok = cache.get("host/api/2.0/b", "")
+	assert.False(t, ok)
+}
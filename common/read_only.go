@@ -0,0 +1,82 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// readOnlyPostPaths are REST API endpoints that use POST method, but don't change anything, like list
+// and search calls with large filters in the request body
+var readOnlyPostPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/api/2\.[01]/clusters/events$`),
+	regexp.MustCompile(`^/api/2\.0/mlflow/(experiments|runs|logged-models)/search$`),
+	regexp.MustCompile(`^/api/2\.0/mlflow/registered-models/get-latest-versions$`),
+	regexp.MustCompile(`^/api/2\.0/vector-search/endpoints/[^/]+/metrics$`),
+	regexp.MustCompile(`^/api/2\.0/vector-search/indexes/[^/]+/(query|query-next-page|scan)$`),
+}
+
+// SetReadOnly makes the client refuse creation, update and deletion of resources
+func (c *DatabricksClient) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+// IsReadOnly returns true if the provider is configured with read_only = true
+func (c *DatabricksClient) IsReadOnly() bool {
+	return c != nil && c.readOnly
+}
+
+// ReadOnlyError is returned when a resource is about to be changed by a provider in read-only mode
+func ReadOnlyError(operation, resourceType string) error {
+	return fmt.Errorf("cannot %s %s: the provider is configured with read_only = true", operation, resourceType)
+}
+
+// ReadSkippedError is returned by Read of resources that are read by executing commands or SQL statements, which
+// are rejected in read-only mode. The state is kept as it is, and the error is shown to the user as a warning.
+type ReadSkippedError struct {
+	Reason string
+}
+
+func (e *ReadSkippedError) Error() string {
+	return e.Reason
+}
+
+// isReadOnlyRequest checks if the request doesn't change anything in Databricks. Requests outside of
+// REST API, like requests for OAuth tokens, are considered read-only.
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if !strings.HasPrefix(req.URL.Path, "/api/") {
+		return true
+	}
+	if req.Method != http.MethodPost {
+		return false
+	}
+	for _, re := range readOnlyPostPaths {
+		if re.MatchString(req.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOnlyTransport wraps the given HTTP transport, so it rejects all REST API requests that could
+// change anything in Databricks
+func ReadOnlyTransport(next http.RoundTripper) http.RoundTripper {
+	return readOnlyTransport{next: next}
+}
+
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		return nil, fmt.Errorf("%s %s is not allowed: the provider is configured with read_only = true",
+			req.Method, req.URL.Path)
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,50 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	for _, tc := range []struct {
+		method   string
+		path     string
+		readOnly bool
+	}{
+		{"GET", "/api/2.1/clusters/get", true},
+		{"HEAD", "/api/2.0/fs/files/a", true},
+		{"POST", "/api/2.1/clusters/create", false},
+		{"PATCH", "/api/2.1/unity-catalog/catalogs/a", false},
+		{"PUT", "/api/2.0/permissions/clusters/abc", false},
+		{"DELETE", "/api/2.0/secrets/scopes/delete", false},
+		{"POST", "/api/2.1/clusters/events", true},
+		{"POST", "/api/2.0/mlflow/experiments/search", true},
+		{"POST", "/api/2.0/vector-search/indexes/a.b.c/query", true},
+		{"POST", "/api/2.0/vector-search/indexes/a.b.c/sync", false},
+		{"POST", "/oidc/v1/token", true},
+	} {
+		req := httptest.NewRequest(tc.method, "https://x"+tc.path, nil)
+		assert.Equal(t, tc.readOnly, isReadOnlyRequest(req), "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: ReadOnlyTransport(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/api/2.1/clusters/get")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	_, err = client.Post(server.URL+"/api/2.1/clusters/delete", "application/json", nil)
+	assert.ErrorContains(t, err, "POST /api/2.1/clusters/delete is not allowed: "+
+		"the provider is configured with read_only = true")
+}
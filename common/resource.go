@@ -3,6 +3,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -27,6 +28,54 @@ type Resource struct {
 	DeprecationMessage              string
 	Importer                        *schema.ResourceImporter
 	CanSkipReadAfterCreateAndUpdate func(d *schema.ResourceData) bool
+	// WithIdentity exposes the resource ID as the `id` attribute of the resource identity,
+	// which is required for the resource to be discoverable through list resources and
+	// importable with `import { identity = {...} }` blocks.
+	WithIdentity bool
+	// DeletionProtection adds the `deletion_protection` attribute, which makes the provider refuse to delete
+	// the resource while it's set to true in the state, so it has to be turned off in a separate apply first.
+	DeletionProtection bool
+}
+
+// DeletionProtectionAttribute is the name of the attribute added to resources having DeletionProtection set.
+const DeletionProtectionAttribute = "deletion_protection"
+
+// DeletionProtectionError is returned when a resource with enabled deletion protection is about to be deleted
+func DeletionProtectionError(resourceType string) error {
+	return fmt.Errorf("cannot delete %s: %s is enabled, set %s = false and apply it before deleting the resource",
+		resourceType, DeletionProtectionAttribute, DeletionProtectionAttribute)
+}
+
+// IdentityAttribute is the name of the resource identity attribute of resources having WithIdentity set.
+const IdentityAttribute = "id"
+
+// setIdentityFromId copies the resource ID into its identity. It's a no-op for resources without identity schema.
+func setIdentityFromId(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return nil
+	}
+	return identity.Set(IdentityAttribute, d.Id())
+}
+
+// setIdFromIdentity sets the resource ID from its identity when it's imported with an identity instead of an ID.
+func setIdFromIdentity(d *schema.ResourceData) error {
+	if d.Id() != "" {
+		return nil
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		return nil
+	}
+	id, ok := identity.Get(IdentityAttribute).(string)
+	if !ok || id == "" {
+		return fmt.Errorf("expected identity to contain %s", IdentityAttribute)
+	}
+	d.SetId(id)
+	return nil
 }
 
 func nicerError(ctx context.Context, err error, action string) error {
@@ -56,6 +105,37 @@ func recoverable(cb func(
 	}
 }
 
+// audited records API requests made by the operation on the resource in the audit log, if it's enabled
+func audited(phase string, sensitiveFields map[string]bool, cb func(
+	ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error) func(
+	ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+	return func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+		ctx = WithAuditInfo(ctx, AuditInfo{
+			ResourceType:    "databricks_" + ResourceName.GetOrUnknown(ctx),
+			ResourceID:      d.Id(),
+			Phase:           phase,
+			SensitiveFields: sensitiveFields,
+		})
+		return cb(ctx, d, c)
+	}
+}
+
+// sensitiveFieldNames returns names of all sensitive fields of the schema, including nested ones
+func sensitiveFieldNames(s map[string]*schema.Schema) map[string]bool {
+	names := map[string]bool{}
+	for k, v := range s {
+		if v.Sensitive {
+			names[k] = true
+		}
+		if nested, ok := v.Elem.(*schema.Resource); ok {
+			for nestedName := range sensitiveFieldNames(nested.Schema) {
+				names[nestedName] = true
+			}
+		}
+	}
+	return names
+}
+
 func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
 	if r.CustomizeDiff == nil {
 		return nil
@@ -85,24 +165,28 @@ func (r Resource) saferCustomizeDiff() schema.CustomizeDiffFunc {
 
 // ToResource converts to Terraform resource definition
 func (r Resource) ToResource() *schema.Resource {
+	sensitiveFields := sensitiveFieldNames(r.Schema)
 	var update func(ctx context.Context, d *schema.ResourceData,
 		m any) diag.Diagnostics
 	if r.Update != nil {
 		update = func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			if err := recoverable(r.Update)(ctx, d, c); err != nil {
+			if c.IsReadOnly() {
+				return diag.FromErr(ReadOnlyError("update", "databricks_"+ResourceName.GetOrUnknown(ctx)))
+			}
+			if err := recoverable(audited("update", sensitiveFields, r.Update))(ctx, d, c); err != nil {
 				err = nicerError(ctx, err, "update")
 				return diag.FromErr(err)
 			}
 			if r.CanSkipReadAfterCreateAndUpdate != nil && r.CanSkipReadAfterCreateAndUpdate(d) {
-				return nil
+				return diag.FromErr(setIdentityFromId(d))
 			}
-			if err := recoverable(r.Read)(ctx, d, c); err != nil {
+			if err := recoverable(audited("read", sensitiveFields, r.Read))(ctx, d, c); err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return diag.FromErr(setIdentityFromId(d))
 		}
 	} else {
 		// set ForceNew to all attributes with CRD
@@ -126,13 +210,31 @@ func (r Resource) ToResource() *schema.Resource {
 			}
 		}
 	}
+	if r.DeletionProtection {
+		r.Schema[DeletionProtectionAttribute] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+		// changes of deletion protection alone don't need any API calls
+		updateOtherFields := update
+		update = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+			if m.(*DatabricksClient).IsReadOnly() {
+				return diag.FromErr(ReadOnlyError("update", "databricks_"+ResourceName.GetOrUnknown(ctx)))
+			}
+			if updateOtherFields == nil ||
+				d.HasChange(DeletionProtectionAttribute) && !d.HasChangeExcept(DeletionProtectionAttribute) {
+				return nil
+			}
+			return updateOtherFields(ctx, d, m)
+		}
+	}
 	// Ignore missing for read for resources, but not for data sources.
 	ignoreMissingForRead := (r.Create != nil || r.Update != nil || r.Delete != nil)
 	generateReadFunc := func(ignoreMissing bool) func(ctx context.Context, d *schema.ResourceData,
 		m any) diag.Diagnostics {
 		return func(ctx context.Context, d *schema.ResourceData,
 			m any) diag.Diagnostics {
-			err := recoverable(r.Read)(ctx, d, m.(*DatabricksClient))
+			err := recoverable(audited("read", sensitiveFields, r.Read))(ctx, d, m.(*DatabricksClient))
 			// TODO: https://github.com/databricks/terraform-provider-databricks/issues/2021
 			if ignoreMissing && apierr.IsMissing(err) {
 				log.Printf("[INFO] %s[id=%s] is removed on backend",
@@ -140,11 +242,19 @@ func (r Resource) ToResource() *schema.Resource {
 				d.SetId("")
 				return nil
 			}
+			var skipped *ReadSkippedError
+			if errors.As(err, &skipped) {
+				return append(diag.Diagnostics{{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("databricks_%s[id=%s] isn't refreshed", ResourceName.GetOrUnknown(ctx), d.Id()),
+					Detail:   skipped.Reason,
+				}}, diag.FromErr(setIdentityFromId(d))...)
+			}
 			if err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return diag.FromErr(setIdentityFromId(d))
 		}
 	}
 	resource := &schema.Resource{
@@ -158,27 +268,51 @@ func (r Resource) ToResource() *schema.Resource {
 		Timeouts:           r.Timeouts,
 		DeprecationMessage: r.DeprecationMessage,
 	}
+	if r.WithIdentity {
+		resource.Identity = &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					IdentityAttribute: {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+					},
+				}
+			},
+		}
+		// some resources, like catalogs and schemas, change their ID when renamed
+		resource.ResourceBehavior.MutableIdentity = true
+	}
 	if r.Create != nil {
 		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 			c := m.(*DatabricksClient)
-			err := recoverable(r.Create)(ctx, d, c)
+			if c.IsReadOnly() {
+				return diag.FromErr(ReadOnlyError("create", "databricks_"+ResourceName.GetOrUnknown(ctx)))
+			}
+			err := recoverable(audited("create", sensitiveFields, r.Create))(ctx, d, c)
 			if err != nil {
 				err = nicerError(ctx, err, "create")
 				return diag.FromErr(err)
 			}
 			if r.CanSkipReadAfterCreateAndUpdate != nil && r.CanSkipReadAfterCreateAndUpdate(d) {
-				return nil
+				return diag.FromErr(setIdentityFromId(d))
 			}
-			if err = recoverable(r.Read)(ctx, d, c); err != nil {
+			if err = recoverable(audited("read", sensitiveFields, r.Read))(ctx, d, c); err != nil {
 				err = nicerError(ctx, err, "read")
 				return diag.FromErr(err)
 			}
-			return nil
+			return diag.FromErr(setIdentityFromId(d))
 		}
 	}
 	if r.Delete != nil {
 		resource.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-			err := recoverable(r.Delete)(ctx, d, m.(*DatabricksClient))
+			c := m.(*DatabricksClient)
+			if c.IsReadOnly() {
+				return diag.FromErr(ReadOnlyError("delete", "databricks_"+ResourceName.GetOrUnknown(ctx)))
+			}
+			if r.DeletionProtection && d.Get(DeletionProtectionAttribute).(bool) {
+				return diag.FromErr(DeletionProtectionError("databricks_" + ResourceName.GetOrUnknown(ctx)))
+			}
+			err := recoverable(audited("delete", sensitiveFields, r.Delete))(ctx, d, c)
 			if apierr.IsMissing(err) {
 				log.Printf("[INFO] %s[id=%s] is removed on backend",
 					ResourceName.GetOrUnknown(ctx), d.Id())
@@ -196,6 +330,9 @@ func (r Resource) ToResource() *schema.Resource {
 		resource.Importer = &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData,
 				m any) (data []*schema.ResourceData, e error) {
+				if err := setIdFromIdentity(d); err != nil {
+					return nil, err
+				}
 				d.MarkNewResource()
 				diags := generateReadFunc(false)(ctx, d, m)
 				var err error
@@ -215,21 +352,27 @@ func MustCompileKeyRE(name string) *regexp.Regexp {
 	return regexp.MustCompile(regexFromName)
 }
 
+// WorkspacePathPrefix is the optional prefix of absolute workspace paths.
+const WorkspacePathPrefix = "/Workspace"
+
+// TrimWorkspacePathPrefix removes the optional `/Workspace` prefix from a workspace path.
+func TrimWorkspacePathPrefix(p string) string {
+	return strings.TrimPrefix(p, WorkspacePathPrefix)
+}
+
 // WorkspacePathPrefixDiffSuppress suppresses diffs for workspace paths where both sides
 // may or may not include the `/Workspace` prefix.
 //
 // This is the case for dashboards, alerts and queries where at create time, the user may include the `/Workspace`
 // prefix for the `parent_path` field, but the read response will not include the prefix.
 func WorkspacePathPrefixDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
-	const prefix = "/Workspace"
-	return strings.TrimPrefix(old, prefix) == strings.TrimPrefix(new, prefix)
+	return TrimWorkspacePathPrefix(old) == TrimWorkspacePathPrefix(new)
 }
 
 // WorkspaceOrEmptyPathPrefixDiffSuppress is similar WorkspacePathPrefixDiffSuppress but also suppresses diffs
 // when the new value is empty (not specified by user).
 func WorkspaceOrEmptyPathPrefixDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
-	const prefix = "/Workspace"
-	return (old != "" && new == "") || strings.TrimPrefix(old, prefix) == strings.TrimPrefix(new, prefix)
+	return (old != "" && new == "") || TrimWorkspacePathPrefix(old) == TrimWorkspacePathPrefix(new)
 }
 
 func EqualFoldDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -127,6 +129,49 @@ func TestUpdateDontSkipRead(t *testing.T) {
 	assert.Equal(t, 2, d.Get("foo"))
 }
 
+func TestIdentityIsSetFromId(t *testing.T) {
+	res := createTestResourceForSkipRead(false)
+	create := res.Create
+	res.Create = func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+		d.SetId("abc")
+		return create(ctx, d, c)
+	}
+	res.WithIdentity = true
+	r := res.ToResource()
+	require.NotNil(t, r.Identity)
+	d := r.TestResourceData()
+	diags := r.CreateContext(context.Background(), d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "abc", identity.Get(IdentityAttribute))
+}
+
+func TestImportingWithIdentity(t *testing.T) {
+	r := Resource{
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return d.Set("foo", 1)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+		WithIdentity: true,
+	}.ToResource()
+	d := r.TestResourceData()
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	require.NoError(t, identity.Set(IdentityAttribute, "abc"))
+
+	datas, err := r.Importer.StateContext(context.Background(), d, &DatabricksClient{})
+	require.NoError(t, err)
+	assert.Len(t, datas, 1)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 1, d.Get("foo"))
+}
+
 func TestHTTP404TriggersResourceRemovalForReadAndDelete(t *testing.T) {
 	nope := func(ctx context.Context,
 		d *schema.ResourceData,
@@ -180,6 +225,202 @@ func TestHTTP404TriggersResourceRemovalForReadAndDelete(t *testing.T) {
 	assert.Equal(t, "", d.Id())
 }
 
+func TestReadOnlyRefusesChanges(t *testing.T) {
+	failIfCalled := func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+		t.Fail()
+		return nil
+	}
+	r := Resource{
+		Create: failIfCalled,
+		Update: failIfCalled,
+		Delete: failIfCalled,
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return d.Set("foo", 2)
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}.ToResource()
+
+	client := &DatabricksClient{}
+	client.SetReadOnly(true)
+	ctx := context.WithValue(context.Background(), ResourceName, "sample")
+	d := r.TestResourceData()
+	d.SetId("a")
+
+	diags := r.CreateContext(ctx, d, client)
+	assert.Equal(t, "cannot create databricks_sample: the provider is configured with read_only = true",
+		diags[0].Summary)
+	diags = r.UpdateContext(ctx, d, client)
+	assert.Equal(t, "cannot update databricks_sample: the provider is configured with read_only = true",
+		diags[0].Summary)
+	diags = r.DeleteContext(ctx, d, client)
+	assert.Equal(t, "cannot delete databricks_sample: the provider is configured with read_only = true",
+		diags[0].Summary)
+
+	diags = r.ReadContext(ctx, d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("foo"))
+}
+
+func TestReadSkippedIsWarning(t *testing.T) {
+	r := Resource{
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return &ReadSkippedError{Reason: "can't be read"}
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}.ToResource()
+
+	ctx := context.WithValue(context.Background(), ResourceName, "sample")
+	d := r.TestResourceData()
+	d.SetId("a")
+	d.Set("foo", 1)
+	diags := r.ReadContext(ctx, d, &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "databricks_sample[id=a] isn't refreshed", diags[0].Summary)
+	assert.Equal(t, "can't be read", diags[0].Detail)
+	assert.Equal(t, "a", d.Id())
+	assert.Equal(t, 1, d.Get("foo"))
+}
+
+func TestDeletionProtection(t *testing.T) {
+	deleted, updated := false, false
+	r := Resource{
+		DeletionProtection: true,
+		Update: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			updated = true
+			return nil
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			deleted = true
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}.ToResource()
+	assert.False(t, r.Schema[DeletionProtectionAttribute].ForceNew)
+
+	ctx := context.WithValue(context.Background(), ResourceName, "sample")
+	state := &terraform.InstanceState{
+		ID: "a",
+		Attributes: map[string]string{
+			"id":                        "a",
+			"foo":                       "bar",
+			DeletionProtectionAttribute: "true",
+		},
+	}
+	diags := r.DeleteContext(ctx, r.Data(state), &DatabricksClient{})
+	assert.Equal(t, "cannot delete databricks_sample: deletion_protection is enabled, "+
+		"set deletion_protection = false and apply it before deleting the resource", diags[0].Summary)
+	assert.False(t, deleted)
+
+	// turning off deletion protection doesn't call the API
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]any{
+		"foo": "bar",
+	}), &DatabricksClient{})
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	newState, diags := r.Apply(ctx, state, diff, &DatabricksClient{})
+	require.False(t, diags.HasError())
+	assert.False(t, updated)
+	assert.NotEqual(t, "true", newState.Attributes[DeletionProtectionAttribute])
+
+	diags = r.DeleteContext(ctx, r.Data(newState), &DatabricksClient{})
+	assert.False(t, diags.HasError())
+	assert.True(t, deleted)
+
+	// other changes are still updated
+	diff, err = r.Diff(ctx, newState, terraform.NewResourceConfigRaw(map[string]any{
+		"foo": "baz",
+	}), &DatabricksClient{})
+	require.NoError(t, err)
+	_, diags = r.Apply(ctx, newState, diff, &DatabricksClient{})
+	require.False(t, diags.HasError())
+	assert.True(t, updated)
+}
+
+func TestDeletionProtectionWithoutUpdate(t *testing.T) {
+	r := Resource{
+		DeletionProtection: true,
+		Schema: map[string]*schema.Schema{
+			"foo": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}.ToResource()
+	assert.True(t, r.Schema["foo"].ForceNew)
+	assert.False(t, r.Schema[DeletionProtectionAttribute].ForceNew)
+	assert.NotNil(t, r.UpdateContext)
+}
+
+func TestAuditInfoInContext(t *testing.T) {
+	var infos []AuditInfo
+	record := func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+		info, ok := AuditInfoFromContext(ctx)
+		require.True(t, ok)
+		infos = append(infos, info)
+		return nil
+	}
+	r := Resource{
+		Create: func(ctx context.Context, d *schema.ResourceData, c *DatabricksClient) error {
+			d.SetId("abc")
+			return record(ctx, d, c)
+		},
+		Read:   record,
+		Delete: record,
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"nested": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}.ToResource()
+
+	ctx := context.WithValue(context.Background(), ResourceName, "sample")
+	d := r.TestResourceData()
+	assert.False(t, r.CreateContext(ctx, d, &DatabricksClient{}).HasError())
+	assert.False(t, r.DeleteContext(ctx, d, &DatabricksClient{}).HasError())
+
+	sensitive := map[string]bool{"token": true, "password": true}
+	assert.Equal(t, []AuditInfo{
+		{ResourceType: "databricks_sample", Phase: "create", SensitiveFields: sensitive},
+		{ResourceType: "databricks_sample", ResourceID: "abc", Phase: "read", SensitiveFields: sensitive},
+		{ResourceType: "databricks_sample", ResourceID: "abc", Phase: "delete", SensitiveFields: sensitive},
+	}, infos)
+}
+
 func TestUpdate(t *testing.T) {
 	r := Resource{
 		Update: func(ctx context.Context,
@@ -288,6 +529,11 @@ func TestWorkspacePathPrefixDiffSuppress(t *testing.T) {
 	assert.False(t, WorkspacePathPrefixDiffSuppress("k", "/Workspace/1", "/Workspace/2", nil))
 }
 
+func TestTrimWorkspacePathPrefix(t *testing.T) {
+	assert.Equal(t, "/foo/bar", TrimWorkspacePathPrefix("/Workspace/foo/bar"))
+	assert.Equal(t, "/foo/bar", TrimWorkspacePathPrefix("/foo/bar"))
+}
+
 func TestWorkspaceOrEmptyPathPrefixDiffSuppress(t *testing.T) {
 	assert.True(t, WorkspaceOrEmptyPathPrefixDiffSuppress("k", "/Workspace/foo/bar", "/Workspace/foo/bar", nil))
 	assert.True(t, WorkspaceOrEmptyPathPrefixDiffSuppress("k", "/Workspace/foo/bar", "/foo/bar", nil))
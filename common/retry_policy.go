@@ -0,0 +1,236 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/logger"
+)
+
+// RetrySettings is the provider-level configuration of retries of transient API errors.
+// Empty values are taken from the defaults or, for overrides, from the provider-level settings.
+type RetrySettings struct {
+	MaxAttempts          int
+	MinBackoff           string
+	MaxBackoff           string
+	RetriableStatusCodes []int
+	RetriableErrorCodes  []string
+}
+
+// RetryOverride changes retry settings for specific resource types
+type RetryOverride struct {
+	ResourceTypes []string
+	RetrySettings
+}
+
+// RetryPolicy decides which failed API requests are retried and how long to wait between attempts
+type RetryPolicy struct {
+	MaxAttempts          int
+	MinBackoff           time.Duration
+	MaxBackoff           time.Duration
+	RetriableStatusCodes []int
+	RetriableErrorCodes  []string
+
+	// Overrides are policies for specific resource types, keyed by resource type without the databricks_ prefix
+	Overrides map[string]*RetryPolicy
+}
+
+// DefaultRetryPolicy is used for settings that are not specified in the provider configuration. Requests failing
+// with 429 and 503 are also retried by the Go SDK for up to 5 minutes, so for them every attempt of the SDK
+// is repeated up to MaxAttempts times by the retry transport
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  1 * time.Second,
+	MaxBackoff:  30 * time.Second,
+	RetriableStatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// NewRetryPolicy creates retry policy from provider settings and overrides for resource types
+func NewRetryPolicy(settings RetrySettings, overrides []RetryOverride) (*RetryPolicy, error) {
+	policy, err := DefaultRetryPolicy.merge(settings)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range overrides {
+		if len(override.ResourceTypes) == 0 {
+			return nil, fmt.Errorf("retry override must have at least one resource type")
+		}
+		overridden, err := policy.merge(override.RetrySettings)
+		if err != nil {
+			return nil, err
+		}
+		for _, resourceType := range override.ResourceTypes {
+			resourceType = strings.TrimPrefix(resourceType, "databricks_")
+			if _, ok := policy.Overrides[resourceType]; ok {
+				return nil, fmt.Errorf("retry settings for %s are overridden more than once", resourceType)
+			}
+			if policy.Overrides == nil {
+				policy.Overrides = map[string]*RetryPolicy{}
+			}
+			policy.Overrides[resourceType] = overridden
+		}
+	}
+	return policy, nil
+}
+
+func (p RetryPolicy) merge(settings RetrySettings) (*RetryPolicy, error) {
+	merged := &RetryPolicy{
+		MaxAttempts:          p.MaxAttempts,
+		MinBackoff:           p.MinBackoff,
+		MaxBackoff:           p.MaxBackoff,
+		RetriableStatusCodes: p.RetriableStatusCodes,
+		RetriableErrorCodes:  p.RetriableErrorCodes,
+	}
+	if settings.MaxAttempts < 0 {
+		return nil, fmt.Errorf("max_attempts must not be negative, got %d", settings.MaxAttempts)
+	}
+	if settings.MaxAttempts > 0 {
+		merged.MaxAttempts = settings.MaxAttempts
+	}
+	var err error
+	if settings.MinBackoff != "" {
+		merged.MinBackoff, err = time.ParseDuration(settings.MinBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min_backoff: %w", err)
+		}
+	}
+	if settings.MaxBackoff != "" {
+		merged.MaxBackoff, err = time.ParseDuration(settings.MaxBackoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_backoff: %w", err)
+		}
+	}
+	if merged.MinBackoff <= 0 || merged.MaxBackoff < merged.MinBackoff {
+		return nil, fmt.Errorf("min_backoff (%s) must be positive and not greater than max_backoff (%s)",
+			merged.MinBackoff, merged.MaxBackoff)
+	}
+	if settings.RetriableStatusCodes != nil {
+		merged.RetriableStatusCodes = settings.RetriableStatusCodes
+	}
+	if settings.RetriableErrorCodes != nil {
+		merged.RetriableErrorCodes = settings.RetriableErrorCodes
+	}
+	return merged, nil
+}
+
+// ForResource returns the policy for a given resource type, taking overrides into account
+func (p *RetryPolicy) ForResource(resourceType string) *RetryPolicy {
+	if overridden, ok := p.Overrides[resourceType]; ok {
+		return overridden
+	}
+	return p
+}
+
+// Backoff returns the time to wait after the given failed attempt, doubling it after every attempt
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.MinBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+// isIdempotent checks if the request could be repeated after it failed with a server error, because it was
+// possibly processed by the backend. Like in net/http, these are requests with methods that don't change anything,
+// and requests with an idempotency key, while, e.g., POST requests creating objects could create duplicates.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != ""
+}
+
+// retriable checks if the response should be retried and returns the error code of the response, if any.
+// The response body is read in order to find the error code and is replaced with an unread copy.
+// Responses with 5xx status codes are retried only for idempotent requests.
+func (p *RetryPolicy) retriable(req *http.Request, resp *http.Response) (bool, string) {
+	if resp.StatusCode < 400 {
+		return false, ""
+	}
+	errorCode := ""
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return false, ""
+		}
+		var apiError struct {
+			ErrorCode string `json:"error_code"`
+		}
+		if json.Unmarshal(body, &apiError) == nil {
+			errorCode = apiError.ErrorCode
+		}
+	}
+	if resp.StatusCode >= 500 && !isIdempotent(req) {
+		return false, errorCode
+	}
+	if slices.Contains(p.RetriableStatusCodes, resp.StatusCode) {
+		return true, errorCode
+	}
+	return errorCode != "" && slices.Contains(p.RetriableErrorCodes, errorCode), errorCode
+}
+
+// Transport wraps the given HTTP transport, so every API request failing with a retriable status code or
+// error code is repeated according to the policy of the resource type that is stored in the request context
+func (p *RetryPolicy) Transport(next http.RoundTripper) http.RoundTripper {
+	return retryTransport{policy: p, next: next}
+}
+
+type retryTransport struct {
+	policy *RetryPolicy
+	next   http.RoundTripper
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	resourceType := ResourceName.GetOrUnknown(ctx)
+	policy := t.policy.ForResource(resourceType)
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+		retriable, errorCode := policy.retriable(req, resp)
+		if !retriable {
+			return resp, nil
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			// request body cannot be sent again
+			return resp, nil
+		}
+		delay := policy.Backoff(attempt)
+		logger.Debugf(ctx, "retry resource=%s method=%s path=%s status=%d error_code=%s attempt=%d max_attempts=%d delay=%s",
+			resourceType, req.Method, req.URL.Path, resp.StatusCode, errorCode, attempt, policy.MaxAttempts, delay)
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
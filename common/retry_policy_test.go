@@ -0,0 +1,209 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy, err := NewRetryPolicy(RetrySettings{
+		MaxAttempts:         5,
+		MaxBackoff:          "10s",
+		RetriableErrorCodes: []string{"RESOURCE_CONFLICT"},
+	}, []RetryOverride{
+		{
+			ResourceTypes: []string{"databricks_cluster", "job"},
+			RetrySettings: RetrySettings{
+				MinBackoff:           "5s",
+				RetriableStatusCodes: []int{409},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, policy.MaxAttempts)
+	assert.Equal(t, time.Second, policy.MinBackoff)
+	assert.Equal(t, 10*time.Second, policy.MaxBackoff)
+	assert.Equal(t, DefaultRetryPolicy.RetriableStatusCodes, policy.RetriableStatusCodes)
+	assert.Equal(t, []string{"RESOURCE_CONFLICT"}, policy.RetriableErrorCodes)
+
+	cluster := policy.ForResource("cluster")
+	assert.Same(t, cluster, policy.ForResource("job"))
+	assert.Equal(t, 5, cluster.MaxAttempts)
+	assert.Equal(t, 5*time.Second, cluster.MinBackoff)
+	assert.Equal(t, []int{409}, cluster.RetriableStatusCodes)
+	assert.Equal(t, []string{"RESOURCE_CONFLICT"}, cluster.RetriableErrorCodes)
+	assert.Same(t, policy, policy.ForResource("notebook"))
+}
+
+func TestNewRetryPolicy_Errors(t *testing.T) {
+	_, err := NewRetryPolicy(RetrySettings{MinBackoff: "abc"}, nil)
+	assert.ErrorContains(t, err, "invalid min_backoff")
+	_, err = NewRetryPolicy(RetrySettings{MinBackoff: "1m", MaxBackoff: "1s"}, nil)
+	assert.EqualError(t, err, "min_backoff (1m0s) must be positive and not greater than max_backoff (1s)")
+	_, err = NewRetryPolicy(RetrySettings{MaxAttempts: -1}, nil)
+	assert.EqualError(t, err, "max_attempts must not be negative, got -1")
+	_, err = NewRetryPolicy(RetrySettings{}, []RetryOverride{{}})
+	assert.EqualError(t, err, "retry override must have at least one resource type")
+	_, err = NewRetryPolicy(RetrySettings{}, []RetryOverride{
+		{ResourceTypes: []string{"job"}},
+		{ResourceTypes: []string{"databricks_job"}},
+	})
+	assert.EqualError(t, err, "retry settings for job are overridden more than once")
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 4*time.Second, policy.Backoff(3))
+	assert.Equal(t, 5*time.Second, policy.Backoff(4))
+	assert.Equal(t, 5*time.Second, policy.Backoff(100))
+}
+
+func TestRetryTransport(t *testing.T) {
+	var bodies []string
+	responses := []struct {
+		status int
+		body   string
+	}{
+		{429, `Too Many Requests`},
+		{400, `{"error_code": "RESOURCE_CONFLICT", "message": "try again"}`},
+		{200, `{"cluster_id": "abc"}`},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		resp := responses[len(bodies)-1]
+		w.WriteHeader(resp.status)
+		w.Write([]byte(resp.body))
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           time.Millisecond,
+		MaxBackoff:           time.Millisecond,
+		RetriableStatusCodes: []int{429},
+		RetriableErrorCodes:  []string{"RESOURCE_CONFLICT"},
+	}
+	client := &http.Client{Transport: policy.Transport(http.DefaultTransport)}
+	req, err := http.NewRequest("POST", server.URL, strings.NewReader(`{"a": "b"}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []string{`{"a": "b"}`, `{"a": "b"}`, `{"a": "b"}`}, bodies)
+}
+
+func TestRetryTransport_ServerErrorsOfNonIdempotentRequests(t *testing.T) {
+	attempts := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts[r.Method]++
+		w.WriteHeader(504)
+		w.Write([]byte(`{"error_code": "TEMPORARILY_UNAVAILABLE", "message": "Gateway Timeout"}`))
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           time.Millisecond,
+		MaxBackoff:           time.Millisecond,
+		RetriableStatusCodes: []int{504},
+		RetriableErrorCodes:  []string{"TEMPORARILY_UNAVAILABLE"},
+	}
+	client := &http.Client{Transport: policy.Transport(http.DefaultTransport)}
+	send := func(req *http.Request) {
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, 504, resp.StatusCode)
+	}
+
+	// POST creating an object could be processed by the backend, so it isn't retried
+	req, err := http.NewRequest("POST", server.URL, strings.NewReader(`{"cluster_name": "a"}`))
+	require.NoError(t, err)
+	send(req)
+	assert.Equal(t, 1, attempts["POST"])
+
+	// unless it has an idempotency key
+	req, err = http.NewRequest("PUT", server.URL, strings.NewReader(`{"cluster_name": "a"}`))
+	require.NoError(t, err)
+	req.Header.Set("Idempotency-Key", "abc")
+	send(req)
+	assert.Equal(t, 3, attempts["PUT"])
+
+	req, err = http.NewRequest("GET", server.URL, nil)
+	require.NoError(t, err)
+	send(req)
+	assert.Equal(t, 3, attempts["GET"])
+}
+
+func TestRetryTransport_Override(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(503)
+		w.Write([]byte(`{"error_code": "TEMPORARILY_UNAVAILABLE"}`))
+	}))
+	defer server.Close()
+
+	policy, err := NewRetryPolicy(RetrySettings{
+		MaxAttempts: 5,
+		MinBackoff:  "1ms",
+		MaxBackoff:  "1ms",
+	}, []RetryOverride{
+		{
+			ResourceTypes: []string{"job"},
+			RetrySettings: RetrySettings{MaxAttempts: 2},
+		},
+		{
+			ResourceTypes: []string{"cluster"},
+			RetrySettings: RetrySettings{RetriableStatusCodes: []int{}},
+		},
+	})
+	require.NoError(t, err)
+	client := &http.Client{Transport: policy.Transport(http.DefaultTransport)}
+
+	for resourceType, expected := range map[string]int{"job": 2, "cluster": 1, "notebook": 5} {
+		attempts = 0
+		ctx := context.WithValue(context.Background(), ResourceName, resourceType)
+		req, err := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, 503, resp.StatusCode)
+		assert.Equal(t, `{"error_code": "TEMPORARILY_UNAVAILABLE"}`, string(body))
+		assert.Equal(t, expected, attempts, resourceType)
+	}
+}
+
+func TestRetryTransport_Canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(429)
+	}))
+	defer server.Close()
+
+	policy := &RetryPolicy{
+		MaxAttempts:          3,
+		MinBackoff:           time.Hour,
+		MaxBackoff:           time.Hour,
+		RetriableStatusCodes: []int{429},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: policy.Transport(http.DefaultTransport)}).Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
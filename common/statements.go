@@ -0,0 +1,215 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+// StatementPollInterval is the interval between checks of the status of long-running SQL statements
+var StatementPollInterval = 1 * time.Second
+
+// statementWaitTimeout is the maximal time that the Statement Execution API allows to wait for results
+const statementWaitTimeout = "50s"
+
+// StatementExecutor executes SQL statements, either on a SQL warehouse or on a cluster, and returns rows
+// of the result
+type StatementExecutor interface {
+	Execute(ctx context.Context, statement string) ([][]string, error)
+}
+
+// StatementMock mocks the execution of SQL statements
+type StatementMock func(statement string) ([][]string, error)
+
+type statementExecutorMock struct {
+	mock StatementMock
+}
+
+// Execute mock statement with given mock function
+func (s statementExecutorMock) Execute(_ context.Context, statement string) ([][]string, error) {
+	return s.mock(statement)
+}
+
+// WithStatementMock mocks all SQL statement executions on SQL warehouses for this client
+func (c *DatabricksClient) WithStatementMock(mock StatementMock) {
+	c.statementFactory = func(_ *databricks.WorkspaceClient, _, _ string) StatementExecutor {
+		return statementExecutorMock{mock: mock}
+	}
+}
+
+// WarehouseStatementExecutor returns executor of SQL statements on a given SQL warehouse that uses
+// the Statement Execution API. Unqualified object names are resolved in the given catalog, or in
+// the default catalog of the workspace if it's empty
+func (c *DatabricksClient) WarehouseStatementExecutor(w *databricks.WorkspaceClient,
+	warehouseID, catalog string) StatementExecutor {
+	if c.statementFactory != nil {
+		return c.statementFactory(w, warehouseID, catalog)
+	}
+	return warehouseStatementExecutor{
+		api:         w.StatementExecution,
+		warehouseID: warehouseID,
+		catalog:     catalog,
+	}
+}
+
+// ClusterStatementExecutor returns executor of SQL statements on a given cluster that uses the Command
+// Execution API
+func (c *DatabricksClient) ClusterStatementExecutor(ctx context.Context, clusterID string) StatementExecutor {
+	return clusterStatementExecutor{
+		exec:      c.CommandExecutor(ctx),
+		clusterID: clusterID,
+	}
+}
+
+type warehouseStatementExecutor struct {
+	api         sql.StatementExecutionInterface
+	warehouseID string
+	catalog     string
+}
+
+func isStatementPending(status *sql.StatementStatus) bool {
+	return status != nil && (status.State == sql.StatementStatePending || status.State == sql.StatementStateRunning)
+}
+
+// statementErrorStatusCodes maps errors of SQL statements to HTTP status codes, so functions like
+// apierr.IsMissing could be used with them
+var statementErrorStatusCodes = map[sql.ServiceErrorCode]int{
+	sql.ServiceErrorCodeAlreadyExists:                   http.StatusConflict,
+	sql.ServiceErrorCodeBadRequest:                      http.StatusBadRequest,
+	sql.ServiceErrorCodeCancelled:                       http.StatusBadRequest,
+	sql.ServiceErrorCodeDeadlineExceeded:                http.StatusGatewayTimeout,
+	sql.ServiceErrorCodeNotFound:                        http.StatusNotFound,
+	sql.ServiceErrorCodeResourceExhausted:               http.StatusTooManyRequests,
+	sql.ServiceErrorCodeServiceUnderMaintenance:         http.StatusServiceUnavailable,
+	sql.ServiceErrorCodeTemporarilyUnavailable:          http.StatusServiceUnavailable,
+	sql.ServiceErrorCodeUnauthenticated:                 http.StatusUnauthorized,
+	sql.ServiceErrorCodeWorkspaceTemporarilyUnavailable: http.StatusServiceUnavailable,
+}
+
+// statementError converts the status of the finished statement into an error
+func statementError(statement string, status *sql.StatementStatus) error {
+	if status == nil {
+		return fmt.Errorf("cannot execute %s: no status returned", statement)
+	}
+	switch status.State {
+	case sql.StatementStateSucceeded:
+		return nil
+	case sql.StatementStateFailed:
+		if status.Error == nil {
+			return fmt.Errorf("cannot execute %s: statement failed", statement)
+		}
+		statusCode, ok := statementErrorStatusCodes[status.Error.ErrorCode]
+		if !ok {
+			statusCode = http.StatusInternalServerError
+		}
+		return &apierr.APIError{
+			ErrorCode:  string(status.Error.ErrorCode),
+			StatusCode: statusCode,
+			Message:    fmt.Sprintf("cannot execute %s: %s", statement, status.Error.Message),
+		}
+	default:
+		return fmt.Errorf("cannot execute %s: statement is %s", statement, status.State)
+	}
+}
+
+// Execute runs the statement on the SQL warehouse, waiting for its completion, and returns all rows of the result
+func (e warehouseStatementExecutor) Execute(ctx context.Context, statement string) ([][]string, error) {
+	log.Printf("[INFO] Executing SQL statement on warehouse %s: %s", e.warehouseID, statement)
+	resp, err := e.api.ExecuteStatement(ctx, sql.ExecuteStatementRequest{
+		Statement:     statement,
+		WarehouseId:   e.warehouseID,
+		Catalog:       e.catalog,
+		WaitTimeout:   statementWaitTimeout,
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for isStatementPending(resp.Status) {
+		log.Printf("[DEBUG] SQL statement %s is %s", resp.StatementId, resp.Status.State)
+		select {
+		case <-ctx.Done():
+			cancelErr := e.api.CancelExecution(context.Background(), sql.CancelExecutionRequest{
+				StatementId: resp.StatementId,
+			})
+			if cancelErr != nil {
+				log.Printf("[WARN] cannot cancel SQL statement %s: %v", resp.StatementId, cancelErr)
+			}
+			return nil, ctx.Err()
+		case <-time.After(StatementPollInterval):
+		}
+		resp, err = e.api.GetStatementByStatementId(ctx, resp.StatementId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = statementError(statement, resp.Status); err != nil {
+		return nil, err
+	}
+	if resp.Result == nil {
+		return nil, nil
+	}
+	rows := resp.Result.DataArray
+	for chunk := resp.Result; chunk.NextChunkInternalLink != ""; {
+		chunk, err = e.api.GetStatementResultChunkN(ctx, sql.GetStatementResultChunkNRequest{
+			StatementId: resp.StatementId,
+			ChunkIndex:  chunk.NextChunkIndex,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, chunk.DataArray...)
+	}
+	return rows, nil
+}
+
+// StatementError is returned when a SQL statement fails on a cluster, Message is the error reported by the cluster
+type StatementError struct {
+	Statement string
+	Message   string
+}
+
+func (e *StatementError) Error() string {
+	return fmt.Sprintf("cannot execute %s: %s", e.Statement, e.Message)
+}
+
+type clusterStatementExecutor struct {
+	exec      CommandExecutor
+	clusterID string
+}
+
+// Execute runs the statement on the cluster and returns all rows of the result
+func (e clusterStatementExecutor) Execute(_ context.Context, statement string) ([][]string, error) {
+	log.Printf("[INFO] Executing SQL statement on cluster %s: %s", e.clusterID, statement)
+	r := e.exec.Execute(e.clusterID, "sql", statement)
+	if r.Failed() {
+		return nil, &StatementError{Statement: statement, Message: r.Error()}
+	}
+	data, ok := r.Data.([]any)
+	if !ok {
+		return nil, nil
+	}
+	rows := make([][]string, 0, len(data))
+	for _, row := range data {
+		values, ok := row.([]any)
+		if !ok {
+			continue
+		}
+		cells := make([]string, 0, len(values))
+		for _, v := range values {
+			if v == nil {
+				cells = append(cells, "")
+			} else {
+				cells = append(cells, fmt.Sprintf("%v", v))
+			}
+		}
+		rows = append(rows, cells)
+	}
+	return rows, nil
+}
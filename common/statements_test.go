@@ -0,0 +1,149 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	StatementPollInterval = 10 * time.Millisecond
+}
+
+func TestWarehouseStatementExecutor_Polling(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	e := w.GetMockStatementExecutionAPI().EXPECT()
+	e.ExecuteStatement(mock.Anything, sql.ExecuteStatementRequest{
+		Statement:     "SELECT 1",
+		WarehouseId:   "abc",
+		Catalog:       "hive_metastore",
+		WaitTimeout:   "50s",
+		OnWaitTimeout: sql.ExecuteStatementRequestOnWaitTimeoutContinue,
+	}).Return(&sql.StatementResponse{
+		StatementId: "s1",
+		Status:      &sql.StatementStatus{State: sql.StatementStatePending},
+	}, nil)
+	e.GetStatementByStatementId(mock.Anything, "s1").Return(&sql.StatementResponse{
+		StatementId: "s1",
+		Status:      &sql.StatementStatus{State: sql.StatementStateRunning},
+	}, nil).Once()
+	e.GetStatementByStatementId(mock.Anything, "s1").Return(&sql.StatementResponse{
+		StatementId: "s1",
+		Status:      &sql.StatementStatus{State: sql.StatementStateSucceeded},
+		Result: &sql.ResultData{
+			DataArray:             [][]string{{"1"}},
+			NextChunkIndex:        1,
+			NextChunkInternalLink: "/api/2.0/sql/statements/s1/result/chunks/1",
+		},
+	}, nil).Once()
+	e.GetStatementResultChunkN(mock.Anything, sql.GetStatementResultChunkNRequest{
+		StatementId: "s1",
+		ChunkIndex:  1,
+	}).Return(&sql.ResultData{
+		DataArray: [][]string{{"2"}},
+	}, nil)
+
+	client := &DatabricksClient{}
+	rows, err := client.WarehouseStatementExecutor(w.WorkspaceClient, "abc", "hive_metastore").Execute(
+		context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"1"}, {"2"}}, rows)
+}
+
+func TestWarehouseStatementExecutor_ErrorMapping(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	w.GetMockStatementExecutionAPI().EXPECT().ExecuteStatement(mock.Anything, mock.Anything).Return(
+		&sql.StatementResponse{
+			StatementId: "s1",
+			Status: &sql.StatementStatus{
+				State: sql.StatementStateFailed,
+				Error: &sql.ServiceError{
+					ErrorCode: sql.ServiceErrorCodeNotFound,
+					Message:   "[TABLE_OR_VIEW_NOT_FOUND] The table or view `a`.`b`.`c` cannot be found.",
+				},
+			},
+		}, nil)
+
+	client := &DatabricksClient{}
+	_, err := client.WarehouseStatementExecutor(w.WorkspaceClient, "abc", "").Execute(context.Background(),
+		"DROP TABLE `a`.`b`.`c`")
+	assert.EqualError(t, err, "cannot execute DROP TABLE `a`.`b`.`c`: "+
+		"[TABLE_OR_VIEW_NOT_FOUND] The table or view `a`.`b`.`c` cannot be found.")
+	assert.True(t, apierr.IsMissing(err))
+}
+
+func TestWarehouseStatementExecutor_Canceled(t *testing.T) {
+	w := mocks.NewMockWorkspaceClient(t)
+	e := w.GetMockStatementExecutionAPI().EXPECT()
+	e.ExecuteStatement(mock.Anything, mock.Anything).Return(&sql.StatementResponse{
+		StatementId: "s1",
+		Status:      &sql.StatementStatus{State: sql.StatementStateRunning},
+	}, nil)
+	e.CancelExecution(mock.Anything, sql.CancelExecutionRequest{StatementId: "s1"}).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := &DatabricksClient{}
+	_, err := client.WarehouseStatementExecutor(w.WorkspaceClient, "abc", "").Execute(ctx, "SELECT 1")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStatementError(t *testing.T) {
+	assert.NoError(t, statementError("a", &sql.StatementStatus{State: sql.StatementStateSucceeded}))
+	assert.EqualError(t, statementError("a", nil), "cannot execute a: no status returned")
+	assert.EqualError(t, statementError("a", &sql.StatementStatus{State: sql.StatementStateClosed}),
+		"cannot execute a: statement is CLOSED")
+	assert.EqualError(t, statementError("a", &sql.StatementStatus{State: sql.StatementStateFailed}),
+		"cannot execute a: statement failed")
+	err := statementError("a", &sql.StatementStatus{
+		State: sql.StatementStateFailed,
+		Error: &sql.ServiceError{ErrorCode: sql.ServiceErrorCodeUnknown, Message: "b"},
+	})
+	var apiErr *apierr.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, "UNKNOWN", apiErr.ErrorCode)
+}
+
+func TestClusterStatementExecutor(t *testing.T) {
+	client := &DatabricksClient{}
+	client.WithCommandMock(func(commandStr string) CommandResults {
+		if commandStr == "SHOW TABLES" {
+			return CommandResults{
+				ResultType: "table",
+				Data: []any{
+					[]any{"a", "b", false},
+					[]any{"a", nil, true},
+				},
+			}
+		}
+		return CommandResults{
+			ResultType: "error",
+			Summary:    "Table not found",
+		}
+	})
+	exec := client.ClusterStatementExecutor(context.Background(), "abc")
+	rows, err := exec.Execute(context.Background(), "SHOW TABLES")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b", "false"}, {"a", "", "true"}}, rows)
+
+	_, err = exec.Execute(context.Background(), "DROP TABLE a")
+	assert.EqualError(t, err, "cannot execute DROP TABLE a: Table not found")
+}
+
+func TestWithStatementMock(t *testing.T) {
+	client := &DatabricksClient{}
+	client.WithStatementMock(func(statement string) ([][]string, error) {
+		return [][]string{{statement}}, nil
+	})
+	rows, err := client.WarehouseStatementExecutor(nil, "abc", "").Execute(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"SELECT 1"}}, rows)
+}
@@ -152,6 +152,16 @@ func (c *DatabricksClient) WorkspaceClientUnifiedProvider(ctx context.Context, d
 	return c.GetWorkspaceClientForUnifiedProvider(ctx, workspaceID)
 }
 
+// WorkspaceClientUnifiedProviderForDiff returns the WorkspaceClient for the workspace ID from the resource diff
+// This is used by CustomizeDiff of resources that are developed over SDKv2.
+func (c *DatabricksClient) WorkspaceClientUnifiedProviderForDiff(ctx context.Context, d *schema.ResourceDiff) (*databricks.WorkspaceClient, error) {
+	workspaceID, ok := d.Get(workspaceIDSchemaKey).(string)
+	if !ok {
+		return c.GetWorkspaceClientForUnifiedProvider(ctx, "")
+	}
+	return c.GetWorkspaceClientForUnifiedProvider(ctx, workspaceID)
+}
+
 // DatabricksClientForUnifiedProvider returns a new Databricks Client for the workspace ID from the resource data
 // This is used by resources and data sources that are developed
 // over SDKv2 and are not using Go SDK.
@@ -191,6 +201,8 @@ func (c *DatabricksClient) getDatabricksClientForUnifiedProvider(ctx context.Con
 			return &DatabricksClient{
 				DatabricksClient: client,
 				commandFactory:   c.commandFactory,
+				statementFactory: c.statementFactory,
+				readOnly:         c.readOnly,
 			}, nil
 		}
 	}
@@ -206,6 +218,8 @@ func (c *DatabricksClient) getDatabricksClientForUnifiedProvider(ctx context.Con
 	return &DatabricksClient{
 		DatabricksClient: c.cachedDatabricksClients[workspaceIDInt],
 		commandFactory:   c.commandFactory,
+		statementFactory: c.statementFactory,
+		readOnly:         c.readOnly,
 	}, nil
 }
 
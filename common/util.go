@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"log"
 	"os"
 	"regexp"
@@ -15,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/listing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -106,3 +108,23 @@ func IgnoreNotFoundError(err error) error {
 	}
 	return err
 }
+
+// ListingSeq adapts an SDK listing iterator to a sequence that can be ranged over, skipping the items
+// for which keep returns false (all items are kept if keep is nil). The sequence stops after the first error.
+func ListingSeq[T any](ctx context.Context, it listing.Iterator[T], keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.HasNext(ctx) {
+			v, err := it.Next(ctx)
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if keep != nil && !keep(v) {
+				continue
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/listing"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,3 +91,17 @@ func TestIgnoreNotFoundError(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestListingSeq(t *testing.T) {
+	ctx := context.Background()
+	it := listing.SliceIterator[int]([]int{1, 2, 3, 4, 5})
+	var got []int
+	for v, err := range ListingSeq[int](ctx, &it, func(v int) bool { return v%2 == 1 }) {
+		assert.NoError(t, err)
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 3}, got)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"slices"
@@ -180,43 +181,46 @@ func (ic *importContext) Find(value, attr string, ref reference, origResource *r
 	return "", nil, false
 }
 
-func (ic *importContext) getTraversalTokens(ref reference, value string, origResource *resource, origPath string) (hclwrite.Tokens, bool) {
+// getTraversalTokens returns tokens referencing the matched resource, together with the traversal to it
+func (ic *importContext) getTraversalTokens(ref reference, value string, origResource *resource,
+	origPath string) (hclwrite.Tokens, hcl.Traversal, bool) {
 	matchType := ref.MatchTypeValue()
 	attr := ref.MatchAttribute()
 	attrValue, traversal, isData := ic.Find(value, attr, ref, origResource, origPath)
 	// at least one invocation of ic.Find will assign Nil to traversal if resource with value is not found
 	if traversal == nil {
-		return nil, isData
+		return nil, nil, isData
 	}
+	tokensTraversal := ic.crossModuleTraversal(origResource, traversal)
 	// capture if it's data?
 	switch matchType {
 	case MatchExact, MatchDefault, MatchCaseInsensitive:
-		return hclwrite.TokensForTraversal(traversal), isData
+		return hclwrite.TokensForTraversal(tokensTraversal), traversal, isData
 	case MatchPrefix, MatchLongestPrefix:
 		rest := value[len(attrValue):]
 		tokens := hclwrite.Tokens{&hclwrite.Token{Type: hclsyntax.TokenOQuote, Bytes: []byte{'"', '$', '{'}}}
-		tokens = append(tokens, hclwrite.TokensForTraversal(traversal)...)
+		tokens = append(tokens, hclwrite.TokensForTraversal(tokensTraversal)...)
 		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte{'}'}})
 		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(maybeAddQuoteCharacter(rest))})
 		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte{'"'}})
-		return tokens, isData
+		return tokens, traversal, isData
 	case MatchRegexp:
 		indices := ref.Regexp.FindStringSubmatchIndex(value)
 		if len(indices) == 4 {
 			tokens := hclwrite.Tokens{&hclwrite.Token{Type: hclsyntax.TokenOQuote, Bytes: []byte{'"'}}}
 			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(maybeAddQuoteCharacter(value[0:indices[2]]))})
 			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenOQuote, Bytes: []byte{'$', '{'}})
-			tokens = append(tokens, hclwrite.TokensForTraversal(traversal)...)
+			tokens = append(tokens, hclwrite.TokensForTraversal(tokensTraversal)...)
 			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte{'}'}})
 			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(maybeAddQuoteCharacter(value[indices[3]:]))})
 			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCQuote, Bytes: []byte{'"'}})
-			return tokens, isData
+			return tokens, traversal, isData
 		}
 		log.Printf("[WARN] Can't match found data in '%s'. Indices: %v", value, indices)
 	default:
 		log.Printf("[WARN] Unsupported match type: %s", ref.MatchType)
 	}
-	return nil, false
+	return nil, nil, false
 }
 
 func (ic *importContext) reference(i importable, path []string, value string, ctyValue cty.Value, origResource *resource) hclwrite.Tokens {
@@ -224,12 +228,14 @@ func (ic *importContext) reference(i importable, path []string, value string, ct
 	match := dependsRe.ReplaceAllString(pathString, "")
 	// get reference candidate, but if it's a `data`, then look for another non-data reference if possible..
 	var dataTokens hclwrite.Tokens
+	var dataTraversal hcl.Traversal
+	candidates := []string{}
 	for _, d := range i.Depends {
 		if d.Path != match {
 			continue
 		}
 		if d.File {
-			relativeFile := fmt.Sprintf("${path.module}/%s", value)
+			relativeFile := ic.relativeFilePath(value)
 			return hclwrite.Tokens{
 				&hclwrite.Token{Type: hclsyntax.TokenOQuote, Bytes: []byte{'"'}},
 				&hclwrite.Token{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(relativeFile)},
@@ -241,19 +247,31 @@ func (ic *importContext) reference(i importable, path []string, value string, ct
 			return ic.variable(varName, "")
 		}
 
-		tokens, isData := ic.getTraversalTokens(d, value, origResource, pathString)
+		tokens, traversal, isData := ic.getTraversalTokens(d, value, origResource, pathString)
 		if tokens != nil {
 			if isData {
 				dataTokens = tokens
+				dataTraversal = traversal
 				log.Printf("[DEBUG] Got reference to data for dependency %v", d)
 			} else {
+				ic.manifest.addReference(origResource, manifestReference{Path: pathString, Value: value,
+					Target: traversalAddress(traversal)})
 				return tokens
 			}
 		}
+		if !slices.Contains(candidates, d.Resource) {
+			candidates = append(candidates, d.Resource)
+		}
 	}
 	if len(dataTokens) > 0 {
+		ic.manifest.addReference(origResource, manifestReference{Path: pathString, Value: value,
+			Target: traversalAddress(dataTraversal)})
 		return dataTokens
 	}
+	if len(candidates) > 0 && value != "" {
+		ic.manifest.addReference(origResource, manifestReference{Path: pathString, Value: value,
+			Candidates: candidates})
+	}
 	return hclwrite.TokensForValue(ctyValue)
 }
 
@@ -749,6 +767,17 @@ func (ic *importContext) pluginFrameworkFieldToHcl(imp importable, path []string
 			}
 
 			nestedSchema := fieldSchema.GetNestedSchema()
+			if pfSchema, ok := fieldSchema.(*PluginFrameworkFieldSchema); ok && pfSchema.isBlock && nestedSchema != nil {
+				// Blocks (i.e., in resources compatible with SDKv2) are generated as `field { ... }`
+				for i, item := range rawList {
+					if nestedData, ok := item.(map[string]interface{}); ok {
+						nestedPath := append(path, fieldName, strconv.Itoa(i))
+						ic.pluginFrameworkNestedObjectToBody(imp, nestedPath, nestedSchema, nestedData, res,
+							body.AppendNewBlock(fieldName, nil).Body())
+					}
+				}
+				return nil
+			}
 			if nestedSchema != nil {
 				// Generate list/set of objects as attribute: field = [{ ... }, { ... }]
 				listTokens := hclwrite.Tokens{}
@@ -930,7 +959,44 @@ func (ic *importContext) pluginFrameworkNestedObjectToTokens(imp importable, pat
 	// Create a temporary body to generate the nested attributes
 	tempFile := hclwrite.NewEmptyFile()
 	tempBody := tempFile.Body()
+	ic.pluginFrameworkNestedObjectToBody(imp, path, nestedSchema, nestedData, res, tempBody)
+
+	// Extract the attributes from the temp body and convert to object tokens
+	attrs := []hclwrite.ObjectAttrTokens{}
+	for name, attr := range tempBody.Attributes() {
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: attr.Expr().BuildTokens(nil),
+		})
+	}
+
+	// Also include any nested blocks (though for Plugin Framework these should be rare)
+	// Convert nested blocks to attribute syntax
+	for _, block := range tempBody.Blocks() {
+		blockName := block.Type()
+		blockBody := block.Body()
+
+		// Recursively convert block body to object tokens
+		blockAttrs := []hclwrite.ObjectAttrTokens{}
+		for name, attr := range blockBody.Attributes() {
+			blockAttrs = append(blockAttrs, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForIdentifier(name),
+				Value: attr.Expr().BuildTokens(nil),
+			})
+		}
+
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(blockName),
+			Value: hclwrite.TokensForObject(blockAttrs),
+		})
+	}
+
+	return hclwrite.TokensForObject(attrs)
+}
 
+// pluginFrameworkNestedObjectToBody generates HCL for fields of a nested object into a given body
+func (ic *importContext) pluginFrameworkNestedObjectToBody(imp importable, path []string,
+	nestedSchema SchemaWrapper, nestedData map[string]interface{}, res *resource, tempBody *hclwrite.Body) {
 	fields := nestedSchema.GetFields()
 	// Sort fields for consistent output (reverse order like SDKv2)
 	sort.Slice(fields, func(i, j int) bool {
@@ -1019,38 +1085,6 @@ func (ic *importContext) pluginFrameworkNestedObjectToTokens(imp importable, pat
 			log.Printf("[WARN] Error generating HCL for nested field %s: %v", fieldName, err)
 		}
 	}
-
-	// Extract the attributes from the temp body and convert to object tokens
-	attrs := []hclwrite.ObjectAttrTokens{}
-	for name, attr := range tempBody.Attributes() {
-		attrs = append(attrs, hclwrite.ObjectAttrTokens{
-			Name:  hclwrite.TokensForIdentifier(name),
-			Value: attr.Expr().BuildTokens(nil),
-		})
-	}
-
-	// Also include any nested blocks (though for Plugin Framework these should be rare)
-	// Convert nested blocks to attribute syntax
-	for _, block := range tempBody.Blocks() {
-		blockName := block.Type()
-		blockBody := block.Body()
-
-		// Recursively convert block body to object tokens
-		blockAttrs := []hclwrite.ObjectAttrTokens{}
-		for name, attr := range blockBody.Attributes() {
-			blockAttrs = append(blockAttrs, hclwrite.ObjectAttrTokens{
-				Name:  hclwrite.TokensForIdentifier(name),
-				Value: attr.Expr().BuildTokens(nil),
-			})
-		}
-
-		attrs = append(attrs, hclwrite.ObjectAttrTokens{
-			Name:  hclwrite.TokensForIdentifier(blockName),
-			Value: hclwrite.TokensForObject(blockAttrs),
-		})
-	}
-
-	return hclwrite.TokensForObject(attrs)
 }
 
 func (ic *importContext) readListFromData(i importable, path []string, res *resource,
@@ -1235,7 +1269,7 @@ func (ic *importContext) generateAndWriteResources(sh *os.File) {
 	for service, ch := range resourceWriters {
 		service := service
 		ch := ch
-		generatedFile := fmt.Sprintf("%s/%s.tf", ic.Directory, service)
+		generatedFile := fmt.Sprintf("%s/%s.tf", ic.moduleDirectory(service), service)
 		log.Printf("[DEBUG] starting writer for service %s", service)
 		writersWaitGroup.Add(1)
 		go func() {
@@ -1335,6 +1369,14 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel,
 						hcl.TraverseRoot{Name: r.Resource},
 						hcl.TraverseAttr{Name: r.Name},
 					}
+					if ic.splitModules {
+						traversal = hcl.Traversal{
+							hcl.TraverseRoot{Name: "module"},
+							hcl.TraverseAttr{Name: ir.Service},
+							hcl.TraverseAttr{Name: r.Resource},
+							hcl.TraverseAttr{Name: r.Name},
+						}
+					}
 					tokens := hclwrite.TokensForTraversal(traversal)
 					imoBlock.Body().SetAttributeRaw("to", tokens)
 					formattedImp := hclwrite.Format(imp.Bytes())
@@ -1345,6 +1387,9 @@ func (ic *importContext) processSingleResource(resourcesChan resourceChannel,
 			}
 			ch, exists := writerChannels[ir.Service]
 			if exists {
+				ic.manifest.addResource(r, ir.Service)
+				ic.addModuleVariables(ir.Service, formatted)
+				ic.trackGeneratedResource(r, writeData.BlockName)
 				ic.waitGroup.Add(1)
 				ch <- writeData
 			} else {
@@ -1457,7 +1502,7 @@ func (ic *importContext) writeNativeImports(importChan importWriteChannel) {
 				continue
 			}
 			_, exists := newImports[blockName]
-			_, deleted := ic.deletedResources[blockName]
+			deleted := ic.isRemovedResource(blockName)
 			if exists {
 				log.Printf("[DEBUG] resource %s already generated, skipping...", blockName)
 			} else if deleted {
@@ -1493,7 +1538,7 @@ func (ic *importContext) writeShellImports(sh *os.File, importChan importWriteCh
 			parts := strings.Split(k, " ")
 			if len(parts) > 3 {
 				resource := parts[2]
-				_, deleted := ic.deletedResources[resource]
+				deleted := ic.isRemovedResource(resource)
 				if deleted {
 					log.Printf("[DEBUG] Resource %s is deleted. Skipping import command for it", resource)
 					continue
@@ -1547,6 +1592,12 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 		existingFile = hclwrite.NewEmptyFile()
 	}
 
+	if ic.splitModules {
+		if err := os.MkdirAll(filepath.Dir(generatedFile), 0755); err != nil {
+			log.Printf("[ERROR] Can't create directory for %s: %v", generatedFile, err)
+			return
+		}
+	}
 	tf, err := os.Create(generatedFile)
 	if err != nil {
 		log.Printf("[ERROR] Can't create %s: %v", generatedFile, err)
@@ -1589,7 +1640,7 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 		for _, block := range existingFile.Body().Blocks() {
 			blockName := generateBlockFullName(block)
 			_, exists := newResources[blockName]
-			_, deleted := ic.deletedResources[blockName]
+			deleted := ic.isRemovedResource(blockName)
 			if exists {
 				log.Printf("[DEBUG] resource %s already generated, skipping...", blockName)
 			} else if deleted {
@@ -1610,6 +1661,9 @@ func (ic *importContext) handleResourceWrite(generatedFile string, ch dataWriteC
 	if numResources == 0 {
 		log.Printf("[DEBUG] removing empty file %s - no resources for a given service", generatedFile)
 		os.Remove(generatedFile)
+		if ic.splitModules {
+			os.Remove(filepath.Dir(generatedFile))
+		}
 	}
 }
 
@@ -1671,6 +1725,7 @@ func (ic *importContext) findDeletedResources() {
 	}
 	// generate IDs of current objects
 	currentObjs := map[string]struct{}{}
+	currentObjIds := map[int64]string{}
 	for _, obj := range ic.allWorkspaceObjects {
 		obj := obj
 		if !isSupportedWorkspaceObject(obj) {
@@ -1678,6 +1733,7 @@ func (ic *importContext) findDeletedResources() {
 		}
 		rid, _ := ic.generateResourceIdForWorkspaceObject(obj)
 		currentObjs[rid] = struct{}{}
+		currentObjIds[obj.ObjectID] = rid
 	}
 	// Loop through previous objects, and if it's missing from the current list, add it to deleted, including permission
 	for _, obj := range ic.oldWorkspaceObjects {
@@ -1693,22 +1749,35 @@ func (ic *importContext) findDeletedResources() {
 		}
 		log.Printf("[DEBUG] object %s is deleted!", rid)
 		ic.deletedResources[rid] = struct{}{}
-		// convert into permissions. This is quite fragile right now, need to think how to handle it better
-		var permId string
-		switch rtype {
-		case "databricks_notebook":
-			permId = "databricks_permissions.notebook_" + rid[len(rtype)+1:]
-		case "databricks_directory":
-			permId = "databricks_permissions.directory_" + rid[len(rtype)+1:]
-		case "databricks_workspace_file":
-			permId = "databricks_permissions.ws_file_" + rid[len(rtype)+1:]
+		// the same object could exist under the new path. It will be tracked as moved if it's generated
+		newRid, moved := currentObjIds[obj.ObjectID]
+		if moved {
+			log.Printf("[DEBUG] object %s is moved to %s", rid, newRid)
+			ic.workspaceMoves[newRid] = rid
 		}
+		// convert into permissions. This is quite fragile right now, need to think how to handle it better
+		permId := workspaceObjectPermissionsId(rtype, rid)
 		log.Printf("[DEBUG] deleted permissions object %s", permId)
 		if permId != "" {
 			ic.deletedResources[permId] = struct{}{}
+			if moved {
+				ic.workspaceMoves[workspaceObjectPermissionsId(rtype, newRid)] = permId
+			}
 		}
 	}
 	log.Printf("[INFO] Finished detection of deleted workspace objects. Detected %d deleted objects.",
 		len(ic.deletedResources))
 	log.Printf("[DEBUG] Deleted objects. %v", ic.deletedResources) // change to TRACE?
 }
+
+func workspaceObjectPermissionsId(rtype, rid string) string {
+	switch rtype {
+	case "databricks_notebook":
+		return "databricks_permissions.notebook_" + rid[len(rtype)+1:]
+	case "databricks_directory":
+		return "databricks_permissions.directory_" + rid[len(rtype)+1:]
+	case "databricks_workspace_file":
+		return "databricks_permissions.ws_file_" + rid[len(rtype)+1:]
+	}
+	return ""
+}
@@ -110,11 +110,17 @@ func Run(args ...string) error {
 	flags.BoolVar(&ic.exportSecrets, "export-secrets", false, "Generate terraform.tfvars with secrets")
 	flags.BoolVar(&ic.noFormat, "noformat", false, "Don't run `terraform fmt` on exported files")
 	flags.BoolVar(&ic.nativeImportSupported, "native-import", false, "Generate native import blocks (requires Terraform 1.5+)")
+	flags.BoolVar(&ic.splitModules, "splitModules", false,
+		"Generate each service as a separate module, with a root module that calls them")
+	flags.BoolVar(&ic.generateManifest, "manifest", false,
+		"Generate manifest of exported resources & their references in JSON and Graphviz formats")
 	flags.StringVar(&ic.updatedSinceStr, "updated-since", "",
 		"Include only resources updated since a given timestamp (in ISO8601 format, i.e. 2023-07-01T00:00:00Z)")
 	flags.BoolVar(&debug, "debug", false, "Print extra debug information.")
 	flags.BoolVar(&trace, "trace", false, "Print full debug information.")
 	flags.BoolVar(&ic.mounts, "mounts", false, "List DBFS mount points.")
+	flags.BoolVar(&ic.legacyQualityMonitors, "legacyQualityMonitors", false,
+		"Check every exported UC table for a legacy quality monitor. Makes one API call per table.")
 	flags.BoolVar(&ic.generateDeclaration, "generateProviderDeclaration", true,
 		"Generate Databricks provider declaration.")
 	flags.BoolVar(&ic.filterDirectoriesDuringWorkspaceWalking, "filterDirectoriesDuringWorkspaceWalking", false,
@@ -139,6 +145,9 @@ func Run(args ...string) error {
 	flags.StringVar(&ic.excludeRegexStr, "excludeRegex", "", "Exclude resource names matching regex during listing operation. "+
 		"This filter applies to all resources that are getting listed, so if you want to import "+
 		"all dependencies of just one cluster, specify -listing=compute")
+	flags.StringVar(&ic.filterStr, "filter", "", "Expression evaluated against the data of resources of listed services, "+
+		"i.e. `resource.type == \"databricks_cluster\" && data.autotermination_minutes == 0`. "+
+		"Only resources for which the expression evaluates to true are exported")
 	prefix := ""
 	flags.StringVar(&prefix, "prefix", "", "Prefix that will be added to the name of all exported resources")
 	var targetCloud string
@@ -149,6 +158,11 @@ func Run(args ...string) error {
 	flags.StringVar(&nodeTypeMappingFile, "nodeTypeMappingFile", "",
 		"Path to JSON file containing node type mappings between clouds. "+
 			"Can only be used with -targetCloud flag.")
+	var recordDir, replayDir string
+	flags.StringVar(&recordDir, "record", "",
+		"Directory to record all API responses into, so the export could be replayed with -replay")
+	flags.StringVar(&replayDir, "replay", "",
+		"Directory with API responses recorded with -record. The export is performed without network access")
 	newArgs := args
 	if len(args) > 1 && args[1] == "exporter" {
 		newArgs = args[2:]
@@ -157,6 +171,10 @@ func Run(args ...string) error {
 	if err != nil {
 		return err
 	}
+	err = setupSnapshot(ic.Client, recordDir, replayDir)
+	if err != nil {
+		return err
+	}
 	if !skipInteractive {
 		configuredListing = ic.interactivePrompts()
 	}
@@ -19,6 +19,8 @@ import (
 	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/compute"
+	sdk_workspace "github.com/databricks/databricks-sdk-go/service/workspace"
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -105,7 +107,10 @@ type importContext struct {
 	exportDeletedUsersAssets                bool
 	incremental                             bool
 	mounts                                  bool
+	legacyQualityMonitors                   bool
 	noFormat                                bool
+	generateManifest                        bool
+	splitModules                            bool
 	nativeImportSupported                   bool
 	services                                map[string]struct{}
 	listing                                 map[string]struct{}
@@ -114,6 +119,8 @@ type importContext struct {
 	matchRegex                              *regexp.Regexp
 	excludeRegexStr                         string
 	excludeRegex                            *regexp.Regexp
+	filterStr                               string
+	filter                                  hcl.Expression
 	filterDirectoriesDuringWorkspaceWalking bool
 	lastActiveDays                          int64
 	lastActiveMs                            int64
@@ -152,6 +159,10 @@ type importContext struct {
 
 	importing      map[string]bool
 	importingMutex sync.RWMutex
+	// listed resources that don't match the `-filter` expression, and resources that are emitted as dependencies
+	// of other resources while being checked against it. Both are guarded by importingMutex
+	filteredOut          map[string]*resource
+	requiredDependencies map[string]struct{}
 
 	sqlDatasources      map[string]string
 	sqlDatasourcesMutex sync.Mutex
@@ -166,17 +177,29 @@ type importContext struct {
 	gitInfoCache      map[string]gitInfoCacheEntry
 	gitInfoCacheMutex sync.RWMutex
 
+	gitCredentials      []sdk_workspace.CredentialInfo
+	gitCredentialsMutex sync.Mutex
+
 	builtInPolicies      map[string]compute.PolicyFamily
 	builtInPoliciesMutex sync.Mutex
 
 	// Workspace-level UC Metastore information
 	currentMetastore *catalog.GetMetastoreSummaryResponse
 
+	allCatalogs   []catalog.CatalogInfo
+	catalogsMutex sync.Mutex
+
 	// tracking ignored objects
 	ignoredResourcesMutex sync.Mutex
 	ignoredResources      map[string]struct{}
 
 	deletedResources map[string]struct{}
+	// incremental mode: old names of objects, and resources that are generated under a new name
+	oldResourceNames    map[string]string
+	workspaceMoves      map[string]string
+	movedResources      map[string]string
+	generatedResources  map[string]struct{}
+	movedResourcesMutex sync.RWMutex
 
 	// emitting of users/SPs
 	emittedUsers      map[string]struct{}
@@ -187,6 +210,13 @@ type importContext struct {
 
 	tfvarsMutex sync.Mutex
 	tfvars      map[string]string
+
+	// manifest of generated resources, nil if generation of manifest isn't requested
+	manifest *exportManifest
+
+	// wiring between service modules, used only with splitModules
+	modules      *splitModulesState
+	modulesMutex sync.Mutex
 }
 
 type mount struct {
@@ -364,6 +394,10 @@ func newImportContext(c *common.DatabricksClient) *importContext {
 		defaultChannel:            make(resourceChannel, defaultHanlerChannelSize),
 		ignoredResources:          map[string]struct{}{},
 		deletedResources:          map[string]struct{}{},
+		oldResourceNames:          map[string]string{},
+		workspaceMoves:            map[string]string{},
+		movedResources:            map[string]string{},
+		generatedResources:        map[string]struct{}{},
 		emittedUsers:              map[string]struct{}{},
 		userOrSpDirectories:       map[string]bool{},
 		services:                  map[string]struct{}{},
@@ -417,6 +451,17 @@ func (ic *importContext) Run() error {
 		}
 		ic.excludeRegex = re
 	}
+	if ic.filterStr != "" {
+		log.Printf("[DEBUG] Using expression '%s' to filter resources", ic.filterStr)
+		expr, err := parseFilterExpression(ic.filterStr)
+		if err != nil {
+			return err
+		}
+		ic.filter = expr
+	}
+	if ic.incremental && ic.splitModules {
+		return fmt.Errorf("-splitModules can't be used together with -incremental")
+	}
 	if ic.incremental {
 		if ic.updatedSinceStr == "" {
 			ic.updatedSinceStr = getLastRunString(statsFileName)
@@ -565,6 +610,7 @@ func (ic *importContext) Run() error {
 		} else {
 			log.Printf("[ERROR] opening %s: %v", shFileName, err)
 		}
+		ic.loadOldResourceNames()
 	}
 	sh, err := os.OpenFile(shFileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
 	if err != nil {
@@ -601,7 +647,25 @@ provider "databricks" {
 		dcfile.Close()
 	}
 	//
+	if ic.generateManifest {
+		ic.manifest = newExportManifest()
+	}
+	if ic.splitModules {
+		ic.modules = newSplitModulesState()
+	}
 	ic.generateAndWriteResources(sh)
+	err = ic.generateModules()
+	if err != nil {
+		log.Printf("[ERROR] can't write module files: %s", err.Error())
+	}
+	err = ic.generateMovedAndRemovedBlocks()
+	if err != nil {
+		log.Printf("[ERROR] can't write moved and removed blocks: %s", err.Error())
+	}
+	err = ic.writeManifest()
+	if err != nil {
+		log.Printf("[ERROR] can't write manifest files: %s", err.Error())
+	}
 	err = ic.generateVariables()
 	if err != nil {
 		log.Printf("[ERROR] can't write variables file: %s", err.Error())
@@ -656,7 +720,11 @@ provider "databricks" {
 
 	if !ic.noFormat {
 		// format generated source code
-		cmd := exec.CommandContext(context.Background(), "terraform", "fmt")
+		args := []string{"fmt"}
+		if ic.splitModules {
+			args = append(args, "-recursive")
+		}
+		cmd := exec.CommandContext(context.Background(), "terraform", args...)
 		cmd.Dir = ic.Directory
 		err = cmd.Run()
 		if err != nil {
@@ -830,7 +898,7 @@ func (ic *importContext) EmitIfUpdatedAfterMillis(r *resource, modifiedAt int64,
 			message, modifiedAt, updatedSinceMs)
 		return
 	}
-	ic.Emit(r)
+	ic.EmitListed(r)
 }
 
 func (ic *importContext) EmitIfUpdatedAfterMillisAndNameMatches(r *resource, name string, modifiedAt int64, message string) {
@@ -846,6 +914,13 @@ func (ic *importContext) EmitIfUpdatedAfterIsoString(r *resource, updatedAt, mes
 			updatedAt, updatedSinceStr)
 		return
 	}
+	ic.EmitListed(r)
+}
+
+// EmitListed emits a resource found by the listing. Only such resources are checked against the `-filter`
+// expression, so dependencies of exported resources are always exported.
+func (ic *importContext) EmitListed(r *resource) {
+	r.Listed = true
 	ic.Emit(r)
 }
 
@@ -878,12 +953,19 @@ func (ic *importContext) Emit(r *resource) {
 	ic.importingMutex.Lock()
 	res, ok := ic.importing[rString]
 	if ok {
+		filtered := ic.requireDependency(r)
+		ic.importingMutex.Unlock()
+		if filtered == nil {
+			log.Printf("[DEBUG] %s already being imported: %v", rString, res)
+			return
+		}
+		log.Printf("[INFO] %s doesn't match filter '%s', but it's a dependency of other resources", rString,
+			ic.filterStr)
+		r = filtered
+	} else {
+		ic.importing[rString] = false // we're starting to add a new resource
 		ic.importingMutex.Unlock()
-		log.Printf("[DEBUG] %s already being imported: %v", rString, res)
-		return
 	}
-	ic.importing[rString] = false // we're starting to add a new resource
-	ic.importingMutex.Unlock()
 
 	// Check if resource is available in either SDKv2 or Plugin Framework provider
 	_, okSDKv2 := ic.Resources[r.Resource]
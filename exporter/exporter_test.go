@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -24,6 +25,7 @@ import (
 	sdk_jobs "github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/postgres"
 	"github.com/databricks/databricks-sdk-go/service/qualitymonitorv2"
 	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/databricks-sdk-go/service/settings"
@@ -48,6 +50,7 @@ import (
 	"github.com/hashicorp/hcl/v2/hclwrite"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // nolint
@@ -281,6 +284,20 @@ var emptyMlflowWebhooks = qa.HTTPFixture{
 	Response:     ml.ListRegistryWebhooks{},
 }
 
+var emptyMlflowExperiments = qa.HTTPFixture{
+	Method:       "GET",
+	ReuseRequest: true,
+	Resource:     "/api/2.0/mlflow/experiments/list?",
+	Response:     ml.ListExperimentsResponse{},
+}
+
+var emptyMlflowModels = qa.HTTPFixture{
+	Method:       "GET",
+	ReuseRequest: true,
+	Resource:     "/api/2.0/mlflow/registered-models/list?",
+	Response:     ml.ListModelsResponse{},
+}
+
 var emptyAlertsV2 = qa.HTTPFixture{
 	Method:       "GET",
 	ReuseRequest: true,
@@ -358,11 +375,10 @@ var emptyProviders = qa.HTTPFixture{
 }
 
 var emptyGitCredentials = qa.HTTPFixture{
-	Method:   http.MethodGet,
-	Resource: "/api/2.0/git-credentials",
-	Response: []sdk_workspace.CredentialInfo{
-		{},
-	},
+	Method:       http.MethodGet,
+	ReuseRequest: true,
+	Resource:     "/api/2.0/git-credentials?",
+	Response:     sdk_workspace.ListCredentialsResponse{},
 }
 
 var emptyAppsSettingsCustomTemplates = qa.HTTPFixture{
@@ -416,6 +432,15 @@ var emptyDatabaseInstances = qa.HTTPFixture{
 	ReuseRequest: true,
 }
 
+var emptyPostgresProjects = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/postgres/projects?",
+	Response: postgres.ListProjectsResponse{
+		Projects: []postgres.Project{},
+	},
+	ReuseRequest: true,
+}
+
 var emptyBudgetPolicies = qa.HTTPFixture{
 	Method:   "GET",
 	Resource: "/api/2.0/accounts/[^/]+/budget/policies?",
@@ -612,6 +637,7 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			emptyDataQualityMonitors,
 			emptyQualityMonitorsV2,
 			emptyDatabaseInstances,
+			emptyPostgresProjects,
 			emptyConnections,
 			emptyTagPolicies,
 			emptyRecipients,
@@ -625,6 +651,8 @@ func TestImportingUsersGroupsSecretScopes(t *testing.T) {
 			emptyStorageCredentials,
 			emptyUcCredentials,
 			emptyMlflowWebhooks,
+			emptyMlflowExperiments,
+			emptyMlflowModels,
 			emptySqlDashboards,
 			emptySqlEndpoints,
 			emptySqlQueries,
@@ -885,6 +913,7 @@ func TestImportingNoResourcesError(t *testing.T) {
 			emptyDataQualityMonitors,
 			emptyQualityMonitorsV2,
 			emptyDatabaseInstances,
+			emptyPostgresProjects,
 			emptyUsersList,
 			emptySpnsList,
 			noCurrentMetastoreAttached,
@@ -903,6 +932,8 @@ func TestImportingNoResourcesError(t *testing.T) {
 			emptyProviders,
 			emptyModelServing,
 			emptyMlflowWebhooks,
+			emptyMlflowExperiments,
+			emptyMlflowModels,
 			emptyWorkspaceConf,
 			emptyInstancePools,
 			emptyClusterPolicies,
@@ -964,188 +995,7 @@ func TestImportingNoResourcesError(t *testing.T) {
 
 func TestImportingClusters(t *testing.T) {
 	qa.HTTPFixturesApply(t,
-		[]qa.HTTPFixture{
-			meAdminFixture,
-			noCurrentMetastoreAttached,
-			emptyRepos,
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain.com%2Flibs%2Ftest.whl&return_git_info=true",
-				Response: tf_workspace.ObjectStatus{},
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain.com%2Frepo%2Ftest.sh&return_git_info=true",
-				Response: tf_workspace.ObjectStatus{},
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/preview/scim/v2/Groups?",
-				Response: scim.GroupList{Resources: []scim.Group{}},
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.2/jobs/list?limit=100",
-				Response: sdk_jobs.ListJobsResponse{},
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.1/clusters/list?filter_by.cluster_sources=UI&filter_by.cluster_sources=API&page_size=100",
-				Response:     getJSONObject("test-data/clusters-list-response.json"),
-				ReuseRequest: true,
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.1/clusters/get?cluster_id=test1",
-				Response:     getJSONObject("test-data/get-cluster-test1-response.json"),
-				ReuseRequest: true,
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.1/clusters/list?filter_by.is_pinned=true&page_size=100",
-				Response: sdk_compute.ListClustersResponse{
-					Clusters: []sdk_compute.ClusterDetails{},
-				},
-				ReuseRequest: true,
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=test1",
-				Response:     getJSONObject("test-data/libraries-cluster-status-test1.json"),
-				ReuseRequest: true,
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/permissions/clusters/test1?",
-				Response: getJSONObject("test-data/get-cluster-permissions-test1-response.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/dbfs/get-status?path=dbfs%3A%2FFileStore%2Fjars%2Ftest.jar",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/get-dbfs-library-status.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/dbfs/read?length=1000000&path=dbfs%3A%2FFileStore%2Fjars%2Ftest.jar",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/get-dbfs-library-data.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.1/clusters/get?cluster_id=test2",
-				Response: getJSONObject("test-data/get-cluster-test2-response.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/libraries/cluster-status?cluster_id=test2",
-				Response: getJSONObject("test-data/libraries-cluster-status-test2.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/permissions/clusters/test2?",
-				Response: getJSONObject("test-data/get-cluster-permissions-test2-response.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/policies/clusters/get?policy_id=123",
-				Response: getJSONObject("test-data/get-cluster-policy.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/permissions/cluster-policies/123?",
-				Response: getJSONObject("test-data/get-cluster-policy-permissions.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.1/clusters/get?cluster_id=awscluster",
-				Response: getJSONObject("test-data/get-cluster-awscluster-response.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=awscluster",
-				Response:     getJSONObject("test-data/libraries-cluster-status-test2.json"),
-				ReuseRequest: true,
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/permissions/clusters/awscluster?",
-				Response: getJSONObject("test-data/get-cluster-permissions-awscluster-response.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/instance-profiles/list",
-				Response: getJSONObject("test-data/list-instance-profiles.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/preview/scim/v2/Me",
-				ReuseRequest: true,
-				Response:     scim.User{ID: "a", DisplayName: "test@test.com"},
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/instance-pools/get?instance_pool_id=pool1",
-				Response:     getJSONObject("test-data/get-instance-pool1.json"),
-				ReuseRequest: true,
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/permissions/instance-pools/pool1?",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/get-job-permissions-14.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/secrets/list?scope=some-kv-scope",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/secret-scopes-list-scope-response.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/secrets/acls/list?scope=some-kv-scope",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/secret-scopes-list-scope-acls-response.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/secrets/acls/get?principal=test%40test.com&scope=some-kv-scope",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/secret-scopes-get-principal-response.json"),
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/secrets/scopes/list",
-				ReuseRequest: true,
-				Response:     getJSONObject("test-data/secret-scopes-response.json"),
-			},
-			{
-				Method:   "GET",
-				Resource: "/api/2.0/libraries/cluster-status?cluster_id=test2",
-				Response: sdk_compute.ClusterLibraryStatuses{
-					ClusterId: "test2",
-					LibraryStatuses: []sdk_compute.LibraryFullStatus{
-						{
-							Library: &sdk_compute.Library{
-								Pypi: &sdk_compute.PythonPyPiLibrary{
-									Package: "chispa",
-								},
-							},
-						},
-					},
-				},
-			},
-			{
-				Method:       "GET",
-				Resource:     "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=10000&startIndex=1",
-				ReuseRequest: true,
-				Response: scim.UserList{
-					Resources: []scim.User{
-						{ID: "123", DisplayName: "test@test.com", UserName: "test@test.com"},
-					},
-				},
-			},
-		},
+		importingClustersFixtures(),
 		func(ctx context.Context, client *common.DatabricksClient) {
 			os.Setenv("EXPORTER_PARALLELISM_default", "1")
 			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
@@ -1179,6 +1029,287 @@ func TestImportingClusters(t *testing.T) {
 		})
 }
 
+// importingClustersFixtures returns fixtures of TestImportingClusters for tests exporting the same clusters
+func importingClustersFixtures() []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		meAdminFixture,
+		noCurrentMetastoreAttached,
+		emptyRepos,
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain.com%2Flibs%2Ftest.whl&return_git_info=true",
+			Response: tf_workspace.ObjectStatus{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/workspace/get-status?path=%2FUsers%2Fuser%40domain.com%2Frepo%2Ftest.sh&return_git_info=true",
+			Response: tf_workspace.ObjectStatus{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?",
+			Response: scim.GroupList{Resources: []scim.Group{}},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.2/jobs/list?limit=100",
+			Response: sdk_jobs.ListJobsResponse{},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.1/clusters/list?filter_by.cluster_sources=UI&filter_by.cluster_sources=API&page_size=100",
+			Response:     getJSONObject("test-data/clusters-list-response.json"),
+			ReuseRequest: true,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.1/clusters/get?cluster_id=test1",
+			Response:     getJSONObject("test-data/get-cluster-test1-response.json"),
+			ReuseRequest: true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/list?filter_by.is_pinned=true&page_size=100",
+			Response: sdk_compute.ListClustersResponse{
+				Clusters: []sdk_compute.ClusterDetails{},
+			},
+			ReuseRequest: true,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/libraries/cluster-status?cluster_id=test1",
+			Response:     getJSONObject("test-data/libraries-cluster-status-test1.json"),
+			ReuseRequest: true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/clusters/test1?",
+			Response: getJSONObject("test-data/get-cluster-permissions-test1-response.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/dbfs/get-status?path=dbfs%3A%2FFileStore%2Fjars%2Ftest.jar",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/get-dbfs-library-status.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/dbfs/read?length=1000000&path=dbfs%3A%2FFileStore%2Fjars%2Ftest.jar",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/get-dbfs-library-data.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/get?cluster_id=test2",
+			Response: getJSONObject("test-data/get-cluster-test2-response.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/libraries/cluster-status?cluster_id=test2",
+			Response: getJSONObject("test-data/libraries-cluster-status-test2.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/clusters/test2?",
+			Response: getJSONObject("test-data/get-cluster-permissions-test2-response.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/policies/clusters/get?policy_id=123",
+			Response: getJSONObject("test-data/get-cluster-policy.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/cluster-policies/123?",
+			Response: getJSONObject("test-data/get-cluster-policy-permissions.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/clusters/get?cluster_id=awscluster",
+			Response: getJSONObject("test-data/get-cluster-awscluster-response.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/libraries/cluster-status?cluster_id=awscluster",
+			Response:     getJSONObject("test-data/libraries-cluster-status-test2.json"),
+			ReuseRequest: true,
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/clusters/awscluster?",
+			Response: getJSONObject("test-data/get-cluster-permissions-awscluster-response.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/instance-profiles/list",
+			Response: getJSONObject("test-data/list-instance-profiles.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/preview/scim/v2/Me",
+			ReuseRequest: true,
+			Response:     scim.User{ID: "a", DisplayName: "test@test.com"},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/instance-pools/get?instance_pool_id=pool1",
+			Response:     getJSONObject("test-data/get-instance-pool1.json"),
+			ReuseRequest: true,
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/permissions/instance-pools/pool1?",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/get-job-permissions-14.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/secrets/list?scope=some-kv-scope",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/secret-scopes-list-scope-response.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/secrets/acls/list?scope=some-kv-scope",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/secret-scopes-list-scope-acls-response.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/secrets/acls/get?principal=test%40test.com&scope=some-kv-scope",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/secret-scopes-get-principal-response.json"),
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/secrets/scopes/list",
+			ReuseRequest: true,
+			Response:     getJSONObject("test-data/secret-scopes-response.json"),
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/libraries/cluster-status?cluster_id=test2",
+			Response: sdk_compute.ClusterLibraryStatuses{
+				ClusterId: "test2",
+				LibraryStatuses: []sdk_compute.LibraryFullStatus{
+					{
+						Library: &sdk_compute.Library{
+							Pypi: &sdk_compute.PythonPyPiLibrary{
+								Package: "chispa",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/preview/scim/v2/Users?attributes=id%2CuserName&count=10000&startIndex=1",
+			ReuseRequest: true,
+			Response: scim.UserList{
+				Resources: []scim.User{
+					{ID: "123", DisplayName: "test@test.com", UserName: "test@test.com"},
+				},
+			},
+		},
+	}
+}
+
+func TestImportingClustersManifest(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		importingClustersFixtures(),
+		func(ctx context.Context, client *common.DatabricksClient) {
+			os.Setenv("EXPORTER_PARALLELISM_default", "1")
+			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+			defer os.RemoveAll(tmpDir)
+
+			ic := newImportContext(client)
+			ic.Directory = tmpDir
+			ic.noFormat = true
+			ic.generateManifest = true
+			ic.enableListing("compute")
+			ic.enableServices("access,users,policies,compute,secrets,groups,storage")
+
+			err := ic.Run()
+			os.Unsetenv("EXPORTER_PARALLELISM_default")
+			assert.NoError(t, err)
+			manifest, err := os.ReadFile(tmpDir + "/" + manifestJsonFileName)
+			assert.NoError(t, err)
+			var manifestData manifestContent
+			assert.NoError(t, json.Unmarshal(manifest, &manifestData))
+			clusterIdx := slices.IndexFunc(manifestData.Resources, func(e *manifestEntry) bool {
+				return e.Address == "databricks_cluster.test_cluster_policy_test2"
+			})
+			require.NotEqual(t, -1, clusterIdx)
+			assert.Equal(t, "compute", manifestData.Resources[clusterIdx].Service)
+			assert.Contains(t, manifestData.Resources[clusterIdx].References, manifestReference{
+				Path: "policy_id", Value: "123", Target: "databricks_cluster_policy.users_cluster_policy",
+			})
+			_, err = os.Stat(tmpDir + "/" + manifestDotFileName)
+			assert.NoError(t, err)
+		})
+}
+
+func TestImportingClustersSplitModules(t *testing.T) {
+	qa.HTTPFixturesApply(t,
+		importingClustersFixtures(),
+		func(ctx context.Context, client *common.DatabricksClient) {
+			os.Setenv("EXPORTER_PARALLELISM_default", "1")
+			tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+			defer os.RemoveAll(tmpDir)
+
+			ic := newImportContext(client)
+			ic.Directory = tmpDir
+			ic.noFormat = true
+			ic.splitModules = true
+			ic.nativeImportSupported = true
+			ic.enableListing("compute")
+			ic.enableServices("access,users,policies,compute,secrets,groups,storage")
+
+			err := ic.Run()
+			os.Unsetenv("EXPORTER_PARALLELISM_default")
+			assert.NoError(t, err)
+			content, err := os.ReadFile(tmpDir + "/compute/compute.tf")
+			assert.NoError(t, err)
+			contentStr := string(content)
+			assert.Contains(t, contentStr, `resource "databricks_cluster" "test_cluster_policy_test2"`)
+			assert.Contains(t, contentStr, `policy_id                    = var.databricks_cluster_policy_users_cluster_policy_id`)
+			assert.Contains(t, contentStr, `jar = var.databricks_dbfs_file__0eee4efe7411a5bdca65d7b79188026c_test_jar_dbfs_path`)
+
+			content, err = os.ReadFile(tmpDir + "/compute/variables.tf")
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `variable "databricks_cluster_policy_users_cluster_policy_id" {
+  description = "Value of databricks_cluster_policy.users_cluster_policy.id from the policies module"
+}`)
+
+			content, err = os.ReadFile(tmpDir + "/policies/outputs.tf")
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `output "databricks_cluster_policy_users_cluster_policy_id" {
+  value = databricks_cluster_policy.users_cluster_policy.id
+}`)
+			content, err = os.ReadFile(tmpDir + "/policies/terraform.tf")
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `source = "databricks/databricks"`)
+
+			content, err = os.ReadFile(tmpDir + "/main.tf")
+			assert.NoError(t, err)
+			contentStr = string(content)
+			assert.Contains(t, contentStr, `module "policies" {`)
+			assert.Regexp(t, `source\s+= "./compute"`, contentStr)
+			assert.Regexp(t, `databricks_cluster_policy_users_cluster_policy_id\s+= module.policies.databricks_cluster_policy_users_cluster_policy_id`,
+				contentStr)
+
+			content, err = os.ReadFile(tmpDir + "/import.tf")
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `to = module.compute.databricks_cluster.test_cluster_policy_test2`)
+			content, err = os.ReadFile(tmpDir + "/import.sh")
+			assert.NoError(t, err)
+			assert.Contains(t, string(content), `terraform import module.policies.databricks_cluster_policy.users_cluster_policy "123"`)
+			_, err = os.Stat(tmpDir + "/compute.tf")
+			assert.True(t, os.IsNotExist(err))
+		})
+}
+
 func TestImportingJobs_JobList(t *testing.T) {
 	qa.HTTPFixturesApply(t,
 		[]qa.HTTPFixture{
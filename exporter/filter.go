@@ -0,0 +1,188 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// functions that could be used in the filter expressions
+var filterFunctions = map[string]function.Function{
+	"contains": stdlib.ContainsFunc,
+	"length":   stdlib.LengthFunc,
+	"lower":    stdlib.LowerFunc,
+	"upper":    stdlib.UpperFunc,
+	"matches":  matchesRegexFunc,
+}
+
+// matchesRegexFunc returns true if the string matches a given regex. It's easier to use in filters than `regex`
+// function of Terraform that returns an error if there is no match
+var matchesRegexFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{Name: "pattern", Type: cty.String},
+		{Name: "string", Type: cty.String},
+	},
+	Type: function.StaticReturnType(cty.Bool),
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		_, err := stdlib.Regex(args[0], args[1])
+		return cty.BoolVal(err == nil), nil
+	},
+})
+
+// parseFilterExpression parses the expression specified in the `-filter` option
+func parseFilterExpression(filter string) (hcl.Expression, error) {
+	expr, diags := hclsyntax.ParseExpression([]byte(filter), "filter", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("can't parse filter expression '%s': %s", filter, diags.Error())
+	}
+	for _, traversal := range expr.Variables() {
+		if name := traversal.RootName(); name != "resource" && name != "data" {
+			return nil, fmt.Errorf("unknown variable '%s' in filter expression '%s'. Only `resource` and `data` are supported",
+				name, filter)
+		}
+	}
+	var unknownFunction string
+	hclsyntax.VisitAll(expr.(hclsyntax.Node), func(n hclsyntax.Node) hcl.Diagnostics {
+		if call, ok := n.(*hclsyntax.FunctionCallExpr); ok && unknownFunction == "" {
+			if _, exists := filterFunctions[call.Name]; !exists {
+				unknownFunction = call.Name
+			}
+		}
+		return nil
+	})
+	if unknownFunction != "" {
+		return nil, fmt.Errorf("unknown function '%s' in filter expression '%s'", unknownFunction, filter)
+	}
+	return expr, nil
+}
+
+// goValueToCty converts values returned by ResourceDataWrapper into cty values used in filter expressions
+func goValueToCty(v any) cty.Value {
+	switch t := v.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType)
+	case string:
+		return cty.StringVal(t)
+	case bool:
+		return cty.BoolVal(t)
+	case int:
+		return cty.NumberIntVal(int64(t))
+	case int64:
+		return cty.NumberIntVal(t)
+	case float64:
+		return cty.NumberFloatVal(t)
+	case *sdkv2schema.Set:
+		return goValueToCty(t.List())
+	case []any:
+		if len(t) == 0 {
+			return cty.EmptyTupleVal
+		}
+		elems := make([]cty.Value, 0, len(t))
+		for _, e := range t {
+			elems = append(elems, goValueToCty(e))
+		}
+		return cty.TupleVal(elems)
+	case map[string]any:
+		if len(t) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attrs := make(map[string]cty.Value, len(t))
+		for k, e := range t {
+			attrs[k] = goValueToCty(e)
+		}
+		return cty.ObjectVal(attrs)
+	case map[string]string:
+		attrs := make(map[string]cty.Value, len(t))
+		for k, e := range t {
+			attrs[k] = cty.StringVal(e)
+		}
+		return cty.ObjectVal(attrs)
+	default:
+		return cty.StringVal(fmt.Sprintf("%v", t))
+	}
+}
+
+// filterEvalContext creates an evaluation context with `resource` and `data` variables for a given resource
+func filterEvalContext(r *resource, ir importable, wrapper ResourceDataWrapper) *hcl.EvalContext {
+	data := map[string]cty.Value{}
+	if wrapper != nil {
+		for _, field := range wrapper.GetSchema().GetFields() {
+			data[field] = goValueToCty(wrapper.Get(field))
+		}
+	}
+	return &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"resource": cty.ObjectVal(map[string]cty.Value{
+				"type":    cty.StringVal(r.Resource),
+				"id":      cty.StringVal(r.ID),
+				"service": cty.StringVal(ir.Service),
+			}),
+			"data": cty.ObjectVal(data),
+		},
+		Functions: filterFunctions,
+	}
+}
+
+// MatchesFilter returns true if a resource should be exported according to the `-filter` option. The filter is
+// applied only to resources emitted by the listing, so dependencies of exported resources are exported as usual.
+// Resources for which the expression can't be evaluated (i.e., when referring to a non-existing attribute)
+// aren't exported.
+func (ic *importContext) MatchesFilter(r *resource, ir importable) bool {
+	if ic.filter == nil || !r.Listed {
+		return true
+	}
+	val, diags := ic.filter.Value(filterEvalContext(r, ir, r.DataWrapper))
+	if diags.HasErrors() {
+		log.Printf("[WARN] can't evaluate filter for %s, it won't be exported: %s", r, diags.Error())
+		return false
+	}
+	if val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.Bool) {
+		log.Printf("[WARN] filter expression for %s returned non-boolean value: %s", r, val.GoString())
+		return false
+	}
+	return val.True()
+}
+
+// markFilteredOut remembers a resource that doesn't match the filter, so it could be exported if other resources
+// depend on it. Returns false if it was already emitted as a dependency, and should be exported.
+func (ic *importContext) markFilteredOut(r *resource) bool {
+	rString := r.String()
+	ic.importingMutex.Lock()
+	defer ic.importingMutex.Unlock()
+	if _, required := ic.requiredDependencies[rString]; required {
+		log.Printf("[INFO] %s doesn't match filter '%s', but it's a dependency of other resources", r, ic.filterStr)
+		return false
+	}
+	if ic.filteredOut == nil {
+		ic.filteredOut = map[string]*resource{}
+	}
+	ic.filteredOut[rString] = r
+	return true
+}
+
+// requireDependency is called with importingMutex held when a resource that is already being imported is emitted
+// again. If it's emitted as a dependency, then it must be exported even if it doesn't match the filter, so
+// this function returns the filtered out resource that should be imported again, or records the requirement if
+// the resource is still being checked.
+func (ic *importContext) requireDependency(r *resource) *resource {
+	if ic.filter == nil || r.Listed {
+		return nil
+	}
+	rString := r.String()
+	if filtered, ok := ic.filteredOut[rString]; ok {
+		delete(ic.filteredOut, rString)
+		filtered.Listed = false
+		return filtered
+	}
+	if ic.requiredDependencies == nil {
+		ic.requiredDependencies = map[string]struct{}{}
+	}
+	ic.requiredDependencies[rString] = struct{}{}
+	return nil
+}
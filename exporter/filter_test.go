@@ -0,0 +1,108 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilterExpression(t *testing.T) {
+	_, err := parseFilterExpression(`resource.type == "databricks_cluster" && data.autotermination_minutes == 0`)
+	assert.NoError(t, err)
+
+	_, err = parseFilterExpression(`resource.type ==`)
+	assert.ErrorContains(t, err, "can't parse filter expression")
+
+	_, err = parseFilterExpression(`cluster.autotermination_minutes == 0`)
+	assert.EqualError(t, err, "unknown variable 'cluster' in filter expression "+
+		"'cluster.autotermination_minutes == 0'. Only `resource` and `data` are supported")
+
+	_, err = parseFilterExpression(`resource.type == "databricks_job" && startswith(resource.id, "1")`)
+	assert.EqualError(t, err, "unknown function 'startswith' in filter expression "+
+		"'resource.type == \"databricks_job\" && startswith(resource.id, \"1\")'")
+}
+
+func TestMatchesFilter(t *testing.T) {
+	ic := importContextForTest()
+	ic.enableListing("compute")
+	d := ic.Resources["databricks_cluster"].TestResourceData()
+	d.SetId("abc")
+	d.Set("cluster_name", "ML Cluster")
+	d.Set("autotermination_minutes", 0)
+	d.Set("custom_tags", map[string]any{"team": "ml"})
+	d.Set("spark_env_vars", map[string]any{})
+	r := &resource{
+		Resource:    "databricks_cluster",
+		ID:          "abc",
+		DataWrapper: &SDKv2ResourceData{data: d, schema: ic.Resources["databricks_cluster"]},
+		Listed:      true,
+	}
+	ir := ic.Importables["databricks_cluster"]
+
+	matches := func(filter string) bool {
+		expr, err := parseFilterExpression(filter)
+		require.NoError(t, err)
+		ic.filter = expr
+		return ic.MatchesFilter(r, ir)
+	}
+	assert.True(t, matches(`resource.type == "databricks_cluster" && data.autotermination_minutes == 0`))
+	assert.True(t, matches(`data.custom_tags.team == "ml"`))
+	assert.True(t, matches(`resource.service == "compute" && resource.id == "abc"`))
+	assert.True(t, matches(`matches("^ML ", data.cluster_name) && lower(data.cluster_name) == "ml cluster"`))
+	assert.False(t, matches(`data.autotermination_minutes > 0`))
+	assert.False(t, matches(`data.custom_tags.team == "bi"`))
+	// non-existent attributes & non-boolean results
+	assert.False(t, matches(`data.custom_tags.owner == "bi"`))
+	assert.False(t, matches(`data.cluster_name`))
+
+	// filter isn't applied to resources that aren't emitted by the listing
+	r.Listed = false
+	assert.True(t, ic.MatchesFilter(r, ir))
+
+	// no filter
+	ic.filter = nil
+	r.Listed = true
+	assert.True(t, ic.MatchesFilter(r, ir))
+}
+
+func TestFilteredOutResourcesAreExportedAsDependencies(t *testing.T) {
+	ic := importContextForTest()
+	expr, err := parseFilterExpression(`resource.type == "databricks_job"`)
+	require.NoError(t, err)
+	ic.filter = expr
+
+	// a listed cluster doesn't match the filter, and is emitted later by a job that uses it
+	listed := &resource{Resource: "databricks_cluster", ID: "abc", Listed: true}
+	assert.True(t, ic.markFilteredOut(listed))
+	ic.importingMutex.Lock()
+	assert.Nil(t, ic.requireDependency(&resource{Resource: "databricks_cluster", ID: "abc", Listed: true}))
+	required := ic.requireDependency(&resource{Resource: "databricks_cluster", ID: "abc"})
+	ic.importingMutex.Unlock()
+	require.NotNil(t, required)
+	assert.Same(t, listed, required)
+	assert.False(t, required.Listed)
+	assert.Empty(t, ic.filteredOut)
+
+	// a job emits a cluster while the listed cluster is checked against the filter
+	ic.importingMutex.Lock()
+	assert.Nil(t, ic.requireDependency(&resource{Resource: "databricks_cluster", ID: "def"}))
+	ic.importingMutex.Unlock()
+	assert.False(t, ic.markFilteredOut(&resource{Resource: "databricks_cluster", ID: "def", Listed: true}))
+	assert.Empty(t, ic.filteredOut)
+}
+
+func TestGoValueToCty(t *testing.T) {
+	v := goValueToCty(map[string]any{
+		"a": []any{1, "b", true, 1.5},
+		"c": nil,
+		"d": map[string]string{"e": "f"},
+		"g": []any{},
+		"h": map[string]any{},
+	})
+	assert.Equal(t, 4, v.GetAttr("a").LengthInt())
+	assert.True(t, v.GetAttr("c").IsNull())
+	assert.Equal(t, "f", v.GetAttr("d").GetAttr("e").AsString())
+	assert.Equal(t, 0, v.GetAttr("g").LengthInt())
+	assert.Empty(t, v.GetAttr("h").Type().AttributeTypes())
+}
@@ -19,7 +19,7 @@ func listAppsSettingsCustomTemplates(ic *importContext) error {
 		if !ic.MatchesName(template.Name) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_apps_settings_custom_template",
 			ID:       template.Name,
 		})
@@ -21,6 +21,7 @@ func TestAppExport(t *testing.T) {
 		noCurrentMetastoreAttached,
 		emptyAppsSettingsCustomTemplates,
 		emptyDatabaseInstances,
+		emptyPostgresProjects,
 		{
 			Method:   "GET",
 			Resource: "/api/2.0/apps?",
@@ -205,6 +206,7 @@ func TestAppExportWithEmptyDescription(t *testing.T) {
 		noCurrentMetastoreAttached,
 		emptyAppsSettingsCustomTemplates,
 		emptyDatabaseInstances,
+		emptyPostgresProjects,
 		{
 			Method:   "GET",
 			Resource: "/api/2.0/apps?",
@@ -23,7 +23,7 @@ func listBudgetPolicies(ic *importContext) error {
 		if !ic.MatchesName(policy.PolicyName) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_budget_policy",
 			ID:       policy.PolicyId,
 		})
@@ -78,7 +78,7 @@ func listBudgets(ic *importContext) error {
 				budget.DisplayName, budget.UpdateTime, updatedSinceMs)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_budget",
 			ID:       ic.accountClient.Config.AccountID + "|" + budget.BudgetConfigurationId,
 			Name:     budget.DisplayName,
@@ -8,6 +8,7 @@ import (
 
 	sdk_compute "github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	tf_clusters "github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 	tf_dlt "github.com/databricks/terraform-provider-databricks/pipelines"
 	tf_workspace "github.com/databricks/terraform-provider-databricks/workspace"
@@ -16,17 +17,8 @@ import (
 
 func listClusters(ic *importContext) error {
 	lastActiveMs := ic.getLastActiveMs()
-	interactiveClusters := []sdk_compute.ClusterSource{sdk_compute.ClusterSourceUi, sdk_compute.ClusterSourceApi}
-
-	it := ic.workspaceClient.Clusters.List(ic.Context, sdk_compute.ListClustersRequest{
-		FilterBy: &sdk_compute.ListClustersFilterBy{
-			ClusterSources: interactiveClusters,
-		},
-		PageSize: 100,
-	})
 	i := 0
-	for it.HasNext(ic.Context) {
-		c, err := it.Next(ic.Context)
+	for c, err := range tf_clusters.ListInteractiveClusters(ic.Context, ic.workspaceClient) {
 		if err != nil {
 			return err
 		}
@@ -44,7 +36,7 @@ func listClusters(ic *importContext) error {
 			log.Printf("[INFO] Old inactive cluster %s", c.ClusterName)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_cluster",
 			ID:       c.ClusterId,
 		})
@@ -113,7 +105,7 @@ func listInstancePools(ic *importContext) error {
 		if !ic.MatchesName(pool.InstancePoolName) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_instance_pool",
 			ID:       pool.InstancePoolId,
 		})
@@ -168,7 +160,7 @@ func listClusterPolicies(ic *importContext) error {
 			log.Printf("[DEBUG] Policy %s doesn't match %s filter", policy.Name, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_cluster_policy",
 			ID:       policy.PolicyId,
 		})
@@ -295,12 +287,8 @@ func importClusterPolicy(ic *importContext, r *resource) error {
 }
 
 func listPipelines(ic *importContext) error {
-	it := ic.workspaceClient.Pipelines.ListPipelines(ic.Context, pipelines.ListPipelinesRequest{
-		MaxResults: 100,
-	})
 	i := 0
-	for it.HasNext(ic.Context) {
-		q, err := it.Next(ic.Context)
+	for q, err := range tf_dlt.ListPipelines(ic.Context, ic.workspaceClient) {
 		if err != nil {
 			return err
 		}
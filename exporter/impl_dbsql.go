@@ -73,17 +73,15 @@ func importQuery(ic *importContext, r *resource) error {
 }
 
 func listSqlEndpoints(ic *importContext) error {
-	it := ic.workspaceClient.Warehouses.List(ic.Context, sql.ListWarehousesRequest{})
 	i := 0
-	for it.HasNext(ic.Context) {
-		q, err := it.Next(ic.Context)
+	for q, err := range tf_sql.ListWarehouses(ic.Context, ic.workspaceClient) {
 		if err != nil {
 			return err
 		}
 		if !ic.MatchesName(q.Name) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_sql_endpoint",
 			ID:       q.Id,
 		})
@@ -253,7 +251,7 @@ func listLakeviewDashboards(ic *importContext) error {
 			continue
 		}
 		// TODO: add emit for incremental mode. But this information isn't included into the List response
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_dashboard",
 			ID:       d.DashboardId,
 		})
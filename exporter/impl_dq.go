@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/dataquality"
 	"github.com/databricks/databricks-sdk-go/service/qualitymonitorv2"
 	data_quality_monitor "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/data_quality_monitor"
 	quality_monitor_v2 "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/quality_monitor_v2"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/qualitymonitor"
 )
 
 func listQualityMonitorsV2(ic *importContext) error {
@@ -17,7 +20,7 @@ func listQualityMonitorsV2(ic *importContext) error {
 		if err != nil {
 			return err
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_quality_monitor_v2",
 			ID:       fmt.Sprintf("%s,%s", monitor.ObjectType, monitor.ObjectId),
 		})
@@ -106,3 +109,70 @@ func importDataQualityMonitor(ic *importContext, r *resource) error {
 
 	return nil
 }
+
+// emitQualityMonitor emits legacy quality monitor (`databricks_quality_monitor`) for a given table if it exists.
+// It requires one API call per table, so it's done only with the `-legacyQualityMonitors` option. When data
+// quality monitors are listed, the same monitors are exported as `databricks_data_quality_monitor`, so we don't
+// emit legacy monitors to avoid duplicates.
+func (ic *importContext) emitQualityMonitor(tableFullName string) {
+	if !ic.legacyQualityMonitors || !ic.isServiceEnabled("dq") || ic.isServiceInListing("dq") {
+		return
+	}
+	_, err := ic.workspaceClient.QualityMonitors.Get(ic.Context, catalog.GetQualityMonitorRequest{
+		TableName: tableFullName,
+	})
+	if err != nil {
+		if !apierr.IsMissing(err) {
+			log.Printf("[WARN] Can't get quality monitor for table %s: %v", tableFullName, err)
+		}
+		return
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_quality_monitor",
+		ID:       tableFullName,
+	})
+}
+
+func importQualityMonitor(ic *importContext, r *resource) error {
+	var monitor catalog.MonitorInfo
+	if err := convertPluginFrameworkToGoSdk(ic, r.DataWrapper,
+		qualitymonitor.MonitorInfoExtended{}, &monitor); err != nil {
+		return err
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_sql_table",
+		ID:       monitor.TableName,
+	})
+	if monitor.OutputSchemaName != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_schema",
+			ID:       monitor.OutputSchemaName,
+		})
+	}
+	if monitor.BaselineTableName != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_sql_table",
+			ID:       monitor.BaselineTableName,
+		})
+	}
+	if monitor.AssetsDir != "" {
+		assetsDir := maybeStripWorkspacePrefix(monitor.AssetsDir)
+		ic.emitUserOrServicePrincipalForPath(assetsDir, "/Users")
+		if ic.isServiceEnabled("directories") {
+			ic.emitDirectoryOrRepo(assetsDir)
+		}
+	}
+	if monitor.Notifications != nil {
+		if monitor.Notifications.OnFailure != nil {
+			for _, email := range monitor.Notifications.OnFailure.EmailAddresses {
+				ic.emitUserOrServicePrincipal(email)
+			}
+		}
+		if monitor.Notifications.OnNewClassificationTagDetected != nil {
+			for _, email := range monitor.Notifications.OnNewClassificationTagDetected.EmailAddresses {
+				ic.emitUserOrServicePrincipal(email)
+			}
+		}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/databricks/databricks-sdk-go/apierr"
+	sdk_uc "github.com/databricks/databricks-sdk-go/service/catalog"
 	sdk_dataquality "github.com/databricks/databricks-sdk-go/service/dataquality"
 	"github.com/databricks/databricks-sdk-go/service/qualitymonitorv2"
 	"github.com/databricks/terraform-provider-databricks/common"
@@ -199,3 +201,83 @@ func TestDataQualityMonitorsExport(t *testing.T) {
 }`))
 	})
 }
+
+func TestQualityMonitorGeneration(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.1/unity-catalog/tables/main.default.tbl/monitor?",
+			Response: sdk_uc.MonitorInfo{
+				TableName:        "main.default.tbl",
+				AssetsDir:        "/Shared/monitors/tbl",
+				OutputSchemaName: "main.monitoring",
+				Status:           "MONITOR_STATUS_ACTIVE",
+				Snapshot:         &sdk_uc.MonitorSnapshot{},
+				Notifications: &sdk_uc.MonitorNotifications{
+					OnFailure: &sdk_uc.MonitorDestination{
+						EmailAddresses: []string{"user@domain.com"},
+					},
+				},
+			},
+		},
+	}, "dq", false, func(ic *importContext) {
+		ic.Emit(&resource{
+			Resource: "databricks_quality_monitor",
+			ID:       "main.default.tbl",
+		})
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		content := getGeneratedFile(ic, "dq")
+		assert.Contains(t, content, `resource "databricks_quality_monitor" "main_default_tbl"`)
+		assert.Contains(t, content, `table_name = "main.default.tbl"`)
+		assert.Contains(t, content, `output_schema_name = "main.monitoring"`)
+		assert.Contains(t, content, `assets_dir = "/Shared/monitors/tbl"`)
+		assert.Contains(t, content, "snapshot {")
+		assert.Contains(t, content, `on_failure {
+      email_addresses = ["user@domain.com"]
+    }`)
+	})
+}
+
+func TestEmitQualityMonitor(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.default.tbl/monitor?",
+			Response: sdk_uc.MonitorInfo{
+				TableName: "main.default.tbl",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/tables/main.default.other/monitor?",
+			Status:   404,
+			Response: &apierr.APIError{
+				ErrorCode:  "NOT_FOUND",
+				StatusCode: 404,
+				Message:    "monitor doesn't exist",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("dq")
+		// tables aren't checked for legacy monitors without -legacyQualityMonitors
+		ic.emitQualityMonitor("main.default.tbl")
+		assert.Empty(t, ic.testEmits)
+
+		ic.legacyQualityMonitors = true
+		ic.emitQualityMonitor("main.default.tbl")
+		ic.emitQualityMonitor("main.default.other")
+		assert.Equal(t, map[string]bool{
+			"databricks_quality_monitor[<unknown>] (id: main.default.tbl)": true,
+		}, ic.testEmits)
+
+		// monitors are exported as databricks_data_quality_monitor when they are listed
+		ic.testEmits = map[string]bool{}
+		ic.enableListing("dq")
+		ic.emitQualityMonitor("main.default.tbl")
+		assert.Empty(t, ic.testEmits)
+	})
+}
@@ -183,7 +183,7 @@ func importTask(ic *importContext, task sdk_jobs.Task, jobName, rID string) {
 						if object.ObjectType != tf_workspace.File {
 							continue
 						}
-						ic.maybeEmitWorkspaceObject("databricks_workspace_file", object.Path, &object)
+						ic.maybeEmitWorkspaceObject("databricks_workspace_file", object.Path, &object, false)
 					}
 				} else {
 					log.Printf("[WARN] Can't list directory %s for DBT task in job %s (id: %s)", directory, jobName, rID)
@@ -300,9 +300,7 @@ func emitWebhookNotifications(ic *importContext, notifications *sdk_jobs.Webhook
 
 func listJobs(ic *importContext) error {
 	i := 0
-	it := ic.workspaceClient.Jobs.List(ic.Context, sdk_jobs.ListJobsRequest{ExpandTasks: false, Limit: 100})
-	for it.HasNext(ic.Context) {
-		job, err := it.Next(ic.Context)
+	for job, err := range tf_jobs.ListJobs(ic.Context, ic.workspaceClient) {
 		if err != nil {
 			return err
 		}
@@ -314,12 +312,7 @@ func listJobs(ic *importContext) error {
 			log.Printf("[INFO] Job name %s doesn't match selection %s", job.Settings.Name, ic.match)
 			continue
 		}
-		if job.Settings.Deployment != nil && job.Settings.Deployment.Kind == "BUNDLE" &&
-			job.Settings.EditMode == "UI_LOCKED" {
-			log.Printf("[INFO] Skipping job '%s' because it's deployed by DABs", job.Settings.Name)
-			continue
-		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_job",
 			ID:       strconv.FormatInt(job.JobId, 10),
 		})
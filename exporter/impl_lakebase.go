@@ -1,11 +1,25 @@
 package exporter
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
 
+	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/database"
+	"github.com/databricks/databricks-sdk-go/service/postgres"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/converters"
 	database_instance_resource "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/database_instance"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_branch"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_catalog"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_database"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_endpoint"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_project"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_role"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/postgres_synced_table"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 )
 
 func listDatabaseInstances(ic *importContext) error {
@@ -46,3 +60,343 @@ func importDatabaseInstance(ic *importContext, r *resource) error {
 		"database_instance_"+r.Name)
 	return nil
 }
+
+// postgresResourceName generates a name from the resource name like `projects/{project_id}/branches/{branch_id}`
+// by joining identifiers of all components, i.e., `{project_id}_{branch_id}`
+func postgresResourceName(name string) string {
+	parts := strings.Split(name, "/")
+	ids := make([]string, 0, len(parts)/2)
+	for i := 1; i < len(parts); i += 2 {
+		ids = append(ids, parts[i])
+	}
+	return strings.Join(ids, "_")
+}
+
+// postgresNameUnified names Postgres resources by their resource names, that are used as IDs
+func postgresNameUnified(_ *importContext, wrapper ResourceDataWrapper) string {
+	return postgresResourceName(wrapper.Id())
+}
+
+// postgresResourceId returns the last component of the resource name, i.e., `branch_id` for the branch
+func postgresResourceId(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// postgresStatusToSpec fills the spec from the status returned by the API, as the spec is an input-only field.
+// Fields of the spec & status have the same JSON names, so fields that aren't in the spec are skipped.
+func postgresStatusToSpec[TStatus any, TSpec any](status *TStatus, spec **TSpec) {
+	if *spec != nil || status == nil {
+		return
+	}
+	data, err := json.Marshal(status)
+	if err != nil {
+		log.Printf("[WARN] can't marshal status: %v", err)
+		return
+	}
+	var newSpec TSpec
+	if err = json.Unmarshal(data, &newSpec); err != nil {
+		log.Printf("[WARN] can't unmarshal spec from status: %v", err)
+		return
+	}
+	*spec = &newSpec
+}
+
+// postgresIdAttribute returns the name of the identifier attribute of the Postgres resource, i.e., `branch_id`
+func postgresIdAttribute(resourceType string) string {
+	return strings.TrimPrefix(resourceType, "databricks_postgres_") + "_id"
+}
+
+// shouldOmitForPostgres keeps the spec & identifier attributes that are optional & computed, as they are filled
+// from the status & resource name by the import functions
+func shouldOmitForPostgres(ic *importContext, pathString string, fieldSchema FieldSchema,
+	wrapper ResourceDataWrapper, r *resource) bool {
+	if pathString == postgresIdAttribute(r.Resource) || pathString == "spec" || strings.HasPrefix(pathString, "spec.") {
+		v, ok := wrapper.GetOk(pathString)
+		return !ok || v == nil || reflect.ValueOf(v).IsZero()
+	}
+	return DefaultShouldOmitFieldFuncWithAbstraction(ic, pathString, fieldSchema, wrapper, r)
+}
+
+// updatePostgresResource converts the state of the Postgres resource into the Go SDK struct, modifies it, and
+// writes it back. The identifier attribute (i.e., `project_id`) isn't a part of the Go SDK struct, so it's set
+// from the resource name.
+func updatePostgresResource[TTF any, TGo any](ic *importContext, r *resource, tfStruct TTF,
+	update func(*TGo)) error {
+	var obj TGo
+	if err := convertPluginFrameworkToGoSdk(ic, r.DataWrapper, tfStruct, &obj); err != nil {
+		return err
+	}
+	update(&obj)
+	var newTfStruct TTF
+	if diags := converters.GoSdkToTfSdkStruct(ic.Context, obj, &newTfStruct); diags.HasError() {
+		return fmt.Errorf("failed to convert Go SDK struct for %s: %v", r.ID, diags)
+	}
+	// attributes that aren't a part of the Go SDK struct (i.e., `provider_config`) stay untyped after conversion,
+	// so we take them from the original state
+	if err := r.DataWrapper.GetTypedStruct(ic.Context, &tfStruct); err != nil {
+		return err
+	}
+	original := reflect.ValueOf(&tfStruct).Elem()
+	updated := reflect.ValueOf(&newTfStruct).Elem()
+	for i := 0; i < updated.NumField(); i++ {
+		if v, ok := updated.Field(i).Interface().(attr.Value); ok && v.IsNull() {
+			updated.Field(i).Set(original.Field(i))
+		}
+	}
+	pfWrapper, ok := r.DataWrapper.(*PluginFrameworkResourceData)
+	if !ok {
+		return fmt.Errorf("%s isn't a Plugin Framework resource", r.ID)
+	}
+	if diags := pfWrapper.state.Set(ic.Context, &newTfStruct); diags.HasError() {
+		return fmt.Errorf("failed to write state for %s: %v", r.ID, diags)
+	}
+	return r.DataWrapper.Set(postgresIdAttribute(r.Resource), postgresResourceId(r.ID))
+}
+
+func listPostgresProjects(ic *importContext) error {
+	projects, err := ic.workspaceClient.Postgres.ListProjectsAll(ic.Context, postgres.ListProjectsRequest{})
+	if err != nil {
+		return err
+	}
+	i := 0
+	for _, project := range projects {
+		projectId := postgresResourceId(project.Name)
+		if !ic.MatchesName(projectId) {
+			log.Printf("[INFO] Skipping Postgres project %s because it doesn't match %s", project.Name, ic.match)
+			continue
+		}
+		var updatedAt int64
+		if project.UpdateTime != nil {
+			updatedAt = project.UpdateTime.AsTime().UnixMilli()
+		}
+		ic.EmitIfUpdatedAfterMillis(&resource{
+			Resource: "databricks_postgres_project",
+			ID:       project.Name,
+		}, updatedAt, fmt.Sprintf("Postgres project '%s'", project.Name))
+		i++
+	}
+	if i > 0 {
+		log.Printf("[INFO] Scanned %d Postgres projects", i)
+	}
+	return nil
+}
+
+func importPostgresProject(ic *importContext, r *resource) error {
+	var project postgres.Project
+	err := updatePostgresResource(ic, r, postgres_project.Project{}, func(p *postgres.Project) {
+		postgresStatusToSpec(p.Status, &p.Spec)
+		project = *p
+	})
+	if err != nil {
+		return err
+	}
+	if project.Spec != nil && project.Spec.BudgetPolicyId != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_budget_policy",
+			ID:       project.Spec.BudgetPolicyId,
+		})
+	}
+	ic.emitPermissionsIfNotIgnored(r, "/database-projects/"+postgresResourceId(r.ID), "database_project_"+r.Name)
+
+	branches, err := ic.workspaceClient.Postgres.ListBranchesAll(ic.Context,
+		postgres.ListBranchesRequest{Parent: r.ID})
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_branch",
+			ID:       branch.Name,
+		})
+	}
+	return nil
+}
+
+func importPostgresBranch(ic *importContext, r *resource) error {
+	var branch postgres.Branch
+	err := updatePostgresResource(ic, r, postgres_branch.Branch{}, func(b *postgres.Branch) {
+		postgresStatusToSpec(b.Status, &b.Spec)
+		branch = *b
+	})
+	if err != nil {
+		return err
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_postgres_project",
+		ID:       branch.Parent,
+	})
+	if branch.Spec != nil && branch.Spec.SourceBranch != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_branch",
+			ID:       branch.Spec.SourceBranch,
+		})
+	}
+
+	endpoints, err := ic.workspaceClient.Postgres.ListEndpointsAll(ic.Context,
+		postgres.ListEndpointsRequest{Parent: r.ID})
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_endpoint",
+			ID:       endpoint.Name,
+		})
+	}
+	roles, err := ic.workspaceClient.Postgres.ListRolesAll(ic.Context, postgres.ListRolesRequest{Parent: r.ID})
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_role",
+			ID:       role.Name,
+		})
+	}
+	databases, err := ic.workspaceClient.Postgres.ListDatabasesAll(ic.Context,
+		postgres.ListDatabasesRequest{Parent: r.ID})
+	if err != nil {
+		return err
+	}
+	for _, database := range databases {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_database",
+			ID:       database.Name,
+		})
+	}
+	return nil
+}
+
+func importPostgresEndpoint(ic *importContext, r *resource) error {
+	var endpoint postgres.Endpoint
+	err := updatePostgresResource(ic, r, postgres_endpoint.Endpoint{}, func(e *postgres.Endpoint) {
+		postgresStatusToSpec(e.Status, &e.Spec)
+		endpoint = *e
+	})
+	if err != nil {
+		return err
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_postgres_branch",
+		ID:       endpoint.Parent,
+	})
+	return nil
+}
+
+func importPostgresRole(ic *importContext, r *resource) error {
+	var role postgres.Role
+	err := updatePostgresResource(ic, r, postgres_role.Role{}, func(pr *postgres.Role) {
+		postgresStatusToSpec(pr.Status, &pr.Spec)
+		role = *pr
+	})
+	if err != nil {
+		return err
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_postgres_branch",
+		ID:       role.Parent,
+	})
+	return nil
+}
+
+func importPostgresDatabase(ic *importContext, r *resource) error {
+	var database postgres.Database
+	err := updatePostgresResource(ic, r, postgres_database.Database{}, func(d *postgres.Database) {
+		postgresStatusToSpec(d.Status, &d.Spec)
+		database = *d
+	})
+	if err != nil {
+		return err
+	}
+	ic.Emit(&resource{
+		Resource: "databricks_postgres_branch",
+		ID:       database.Parent,
+	})
+	if database.Spec != nil && database.Spec.Role != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_role",
+			ID:       database.Spec.Role,
+		})
+	}
+	return nil
+}
+
+// listPostgresCatalogs emits Postgres catalogs for all online catalogs. Catalogs of database instances are also
+// online catalogs, so they will be skipped as not found in the Postgres API.
+func listPostgresCatalogs(ic *importContext) error {
+	if ic.currentMetastore == nil {
+		log.Printf("[INFO] Skipping listing of Postgres catalogs because there is no UC metastore")
+		return nil
+	}
+	catalogs, err := ic.getCatalogs()
+	if err != nil {
+		return err
+	}
+	for _, v := range catalogs {
+		if v.CatalogType != catalog.CatalogTypeManagedOnlineCatalog {
+			continue
+		}
+		ic.EmitIfUpdatedAfterMillisAndNameMatches(&resource{
+			Resource: "databricks_postgres_catalog",
+			ID:       "catalogs/" + v.Name,
+		}, v.Name, v.UpdatedAt, fmt.Sprintf("Postgres catalog '%s'", v.Name))
+	}
+	return nil
+}
+
+func importPostgresCatalog(ic *importContext, r *resource) error {
+	var cat postgres.Catalog
+	err := updatePostgresResource(ic, r, postgres_catalog.Catalog{}, func(c *postgres.Catalog) {
+		if c.Spec == nil && c.Status != nil {
+			c.Spec = &postgres.CatalogCatalogSpec{
+				Branch:           c.Status.Branch,
+				PostgresDatabase: c.Status.PostgresDatabase,
+			}
+		}
+		cat = *c
+	})
+	if err != nil {
+		return err
+	}
+	if cat.Spec != nil && cat.Spec.Branch != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_branch",
+			ID:       cat.Spec.Branch,
+		})
+	}
+	return nil
+}
+
+func importPostgresSyncedTable(ic *importContext, r *resource) error {
+	var table postgres.SyncedTable
+	err := updatePostgresResource(ic, r, postgres_synced_table.SyncedTable{},
+		func(t *postgres.SyncedTable) {
+			table = *t
+		})
+	if err != nil {
+		return err
+	}
+	if table.Spec == nil {
+		log.Printf("[WARN] Specification of the synced table %s isn't returned by the API", r.ID)
+		return nil
+	}
+	if table.Spec.Branch != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_postgres_branch",
+			ID:       table.Spec.Branch,
+		})
+	}
+	if table.Spec.SourceTableFullName != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_sql_table",
+			ID:       table.Spec.SourceTableFullName,
+		})
+	}
+	if table.Spec.NewPipelineSpec != nil && table.Spec.NewPipelineSpec.StorageCatalog != "" {
+		ic.Emit(&resource{
+			Resource: "databricks_catalog",
+			ID:       table.Spec.NewPipelineSpec.StorageCatalog,
+		})
+	}
+	return nil
+}
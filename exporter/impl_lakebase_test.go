@@ -6,13 +6,16 @@ import (
 	"os"
 	"testing"
 
+	sdk_uc "github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/databricks/databricks-sdk-go/service/database"
 	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/postgres"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/permissions/entity"
 	"github.com/databricks/terraform-provider-databricks/qa"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDatabaseInstanceName(t *testing.T) {
@@ -154,6 +157,7 @@ func TestDatabaseInstanceExport(t *testing.T) {
 				AccessControlList: []iam.AccessControlRequest{},
 			},
 		},
+		emptyPostgresProjects,
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
 		defer os.RemoveAll(tmpDir)
@@ -185,3 +189,351 @@ func TestDatabaseInstanceExport(t *testing.T) {
 		// This is documented in COMPLEX_TYPES_HANDLING.md as a known limitation
 	})
 }
+
+func TestPostgresResourceName(t *testing.T) {
+	for id, expected := range map[string]string{
+		"projects/my-project":                                 "my-project",
+		"projects/my-project/branches/main":                   "my-project_main",
+		"projects/my-project/branches/main/endpoints/primary": "my-project_main_primary",
+		"catalogs/lakebase_catalog":                           "lakebase_catalog",
+		"synced_tables/main.default.customers":                "main.default.customers",
+	} {
+		assert.Equal(t, expected, postgresResourceName(id), id)
+		assert.Equal(t, expected, postgresNameUnified(nil, &PluginFrameworkResourceData{resourceId: id}), id)
+	}
+}
+
+func TestPostgresProjectExport(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		meAdminFixture,
+		noCurrentMetastoreAttached,
+		emptyDatabaseInstances,
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects?",
+			Response: postgres.ListProjectsResponse{
+				Projects: []postgres.Project{{Name: "projects/my-project"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project?",
+			Response: postgres.Project{
+				Name: "projects/my-project",
+				Status: &postgres.ProjectStatus{
+					DisplayName:   "My Project",
+					PgVersion:     17,
+					DefaultBranch: "projects/my-project/branches/main",
+					Owner:         "user@domain.com",
+				},
+			},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/permissions/database-projects/my-project?",
+			ReuseRequest: true,
+			Response: entity.PermissionsEntity{
+				ObjectType:        "database-projects",
+				AccessControlList: []iam.AccessControlRequest{},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches?",
+			Response: postgres.ListBranchesResponse{
+				Branches: []postgres.Branch{
+					{Name: "projects/my-project/branches/main"},
+					{Name: "projects/my-project/branches/dev"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main?",
+			Response: postgres.Branch{
+				Name:   "projects/my-project/branches/main",
+				Parent: "projects/my-project",
+				Status: &postgres.BranchStatus{
+					Default:     true,
+					IsProtected: true,
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/dev?",
+			Response: postgres.Branch{
+				Name:   "projects/my-project/branches/dev",
+				Parent: "projects/my-project",
+				Status: &postgres.BranchStatus{
+					SourceBranch: "projects/my-project/branches/main",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/endpoints?",
+			Response: postgres.ListEndpointsResponse{
+				Endpoints: []postgres.Endpoint{{Name: "projects/my-project/branches/main/endpoints/primary"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/dev/endpoints?",
+			Response: postgres.ListEndpointsResponse{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/endpoints/primary?",
+			Response: postgres.Endpoint{
+				Name:   "projects/my-project/branches/main/endpoints/primary",
+				Parent: "projects/my-project/branches/main",
+				Status: &postgres.EndpointStatus{
+					EndpointType:          postgres.EndpointTypeEndpointTypeReadWrite,
+					AutoscalingLimitMinCu: 0.5,
+					AutoscalingLimitMaxCu: 2,
+					CurrentState:          postgres.EndpointStatusStateActive,
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/roles?",
+			Response: postgres.ListRolesResponse{
+				Roles: []postgres.Role{{Name: "projects/my-project/branches/main/roles/app-owner"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/dev/roles?",
+			Response: postgres.ListRolesResponse{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/roles/app-owner?",
+			Response: postgres.Role{
+				Name:   "projects/my-project/branches/main/roles/app-owner",
+				Parent: "projects/my-project/branches/main",
+				Status: &postgres.RoleRoleStatus{
+					PostgresRole: "app_owner",
+					AuthMethod:   postgres.RoleAuthMethodPgPasswordScramSha256,
+					IdentityType: postgres.RoleIdentityTypeUser,
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/databases?",
+			Response: postgres.ListDatabasesResponse{
+				Databases: []postgres.Database{{Name: "projects/my-project/branches/main/databases/app"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/dev/databases?",
+			Response: postgres.ListDatabasesResponse{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/databases/app?",
+			Response: postgres.Database{
+				Name:   "projects/my-project/branches/main/databases/app",
+				Parent: "projects/my-project/branches/main",
+				Status: &postgres.DatabaseDatabaseStatus{
+					PostgresDatabase: "app",
+					Role:             "projects/my-project/branches/main/roles/app-owner",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+		defer os.RemoveAll(tmpDir)
+
+		ic := newImportContext(client)
+		ic.noFormat = true
+		ic.Directory = tmpDir
+		ic.enableListing("lakebase")
+		ic.enableServices("lakebase")
+
+		err := ic.Run()
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(tmpDir + "/lakebase.tf")
+		assert.NoError(t, err)
+		contentStr := normalizeWhitespace(string(content))
+
+		assert.Contains(t, contentStr, `resource "databricks_postgres_project" "my_project"`)
+		assert.Contains(t, contentStr, `project_id = "my-project"`)
+		assert.Contains(t, contentStr, `display_name = "My Project"`)
+		assert.Contains(t, contentStr, `resource "databricks_postgres_branch" "my_project_dev"`)
+		assert.Contains(t, contentStr, `parent = databricks_postgres_project.my_project.name`)
+		assert.Contains(t, contentStr, `source_branch = databricks_postgres_branch.my_project_main.name`)
+		assert.Contains(t, contentStr, `resource "databricks_postgres_endpoint" "my_project_main_primary"`)
+		assert.Contains(t, contentStr, `endpoint_type = "ENDPOINT_TYPE_READ_WRITE"`)
+		assert.Contains(t, contentStr, `parent = databricks_postgres_branch.my_project_main.name`)
+		assert.Contains(t, contentStr, `resource "databricks_postgres_role" "my_project_main_app_owner"`)
+		assert.Contains(t, contentStr, `role_id = "app-owner"`)
+		assert.Contains(t, contentStr, `resource "databricks_postgres_database" "my_project_main_app"`)
+		assert.Contains(t, contentStr, `role = databricks_postgres_role.my_project_main_app_owner.name`)
+	})
+}
+
+func TestPostgresCatalogExport(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		meAdminFixture,
+		currentMetastoreSuccess,
+		emptyDatabaseInstances,
+		emptyPostgresProjects,
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/catalogs?",
+			Response: sdk_uc.ListCatalogsResponse{
+				Catalogs: []sdk_uc.CatalogInfo{
+					{Name: "main", CatalogType: sdk_uc.CatalogTypeManagedCatalog},
+					{Name: "lakebase_catalog", CatalogType: sdk_uc.CatalogTypeManagedOnlineCatalog},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/catalogs/lakebase_catalog?",
+			Response: postgres.Catalog{
+				Name: "catalogs/lakebase_catalog",
+				Status: &postgres.CatalogCatalogStatus{
+					Branch:           "projects/my-project/branches/main",
+					PostgresDatabase: "app",
+					Project:          "projects/my-project",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main?",
+			Response: postgres.Branch{
+				Name:   "projects/my-project/branches/main",
+				Parent: "projects/my-project",
+				Status: &postgres.BranchStatus{Default: true},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project?",
+			Response: postgres.Project{
+				Name:   "projects/my-project",
+				Status: &postgres.ProjectStatus{PgVersion: 17},
+			},
+		},
+		{
+			Method:       "GET",
+			Resource:     "/api/2.0/permissions/database-projects/my-project?",
+			ReuseRequest: true,
+			Response: entity.PermissionsEntity{
+				ObjectType:        "database-projects",
+				AccessControlList: []iam.AccessControlRequest{},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches?",
+			Response: postgres.ListBranchesResponse{
+				Branches: []postgres.Branch{{Name: "projects/my-project/branches/main"}},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/endpoints?",
+			Response: postgres.ListEndpointsResponse{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/roles?",
+			Response: postgres.ListRolesResponse{},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/projects/my-project/branches/main/databases?",
+			Response: postgres.ListDatabasesResponse{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+		defer os.RemoveAll(tmpDir)
+
+		ic := newImportContext(client)
+		ic.noFormat = true
+		ic.Directory = tmpDir
+		ic.enableListing("lakebase")
+		ic.enableServices("lakebase")
+
+		err := ic.Run()
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(tmpDir + "/lakebase.tf")
+		assert.NoError(t, err)
+		contentStr := normalizeWhitespace(string(content))
+
+		assert.Contains(t, contentStr, `resource "databricks_postgres_catalog" "lakebase_catalog"`)
+		assert.Contains(t, contentStr, `catalog_id = "lakebase_catalog"`)
+		assert.Contains(t, contentStr, `branch = databricks_postgres_branch.my_project_main.name`)
+		assert.Contains(t, contentStr, `postgres_database = "app"`)
+		assert.Contains(t, contentStr, `resource "databricks_postgres_project" "my_project"`)
+	})
+}
+
+func TestPostgresCatalogsReuseCatalogListing(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.1/unity-catalog/catalogs?",
+			Response: sdk_uc.ListCatalogsResponse{
+				Catalogs: []sdk_uc.CatalogInfo{
+					{Name: "main", CatalogType: sdk_uc.CatalogTypeManagedCatalog},
+					{Name: "lakebase_catalog", CatalogType: sdk_uc.CatalogTypeManagedOnlineCatalog},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("uc-catalogs,lakebase")
+		ic.currentMetastore = currentMetastoreResponse
+		assert.NoError(t, resourcesMap["databricks_catalog"].List(ic))
+		assert.NoError(t, resourcesMap["databricks_postgres_catalog"].List(ic))
+		assert.Equal(t, 2, len(ic.testEmits))
+		assert.True(t, ic.testEmits["databricks_catalog[main_test_MANAGED_CATALOG] (id: main)"])
+		assert.True(t, ic.testEmits["databricks_postgres_catalog[<unknown>] (id: catalogs/lakebase_catalog)"])
+	})
+}
+
+func TestPostgresSyncedTableImport(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/postgres/synced_tables/main.default.customers?",
+			Response: postgres.SyncedTable{
+				Name: "synced_tables/main.default.customers",
+				Spec: &postgres.SyncedTableSyncedTableSpec{
+					Branch:              "projects/my-project/branches/main",
+					SourceTableFullName: "main.default.customers_source",
+					NewPipelineSpec: &postgres.NewPipelineSpec{
+						StorageCatalog: "lakebase_storage",
+						StorageSchema:  "pipelines",
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		ic := importContextForTestWithClient(ctx, client)
+		ic.enableServices("lakebase,uc-catalogs,uc-tables")
+		r := &resource{
+			Resource: "databricks_postgres_synced_table",
+			ID:       "synced_tables/main.default.customers",
+		}
+		ir := resourcesMap[r.Resource]
+		r.DataWrapper = ic.readPluginFrameworkResource(r, ir)
+		require.NotNil(t, r.DataWrapper)
+		assert.NoError(t, ir.Import(ic, r))
+		assert.Equal(t, 3, len(ic.testEmits))
+		assert.True(t, ic.testEmits["databricks_postgres_branch[<unknown>] (id: projects/my-project/branches/main)"])
+		assert.True(t, ic.testEmits["databricks_sql_table[<unknown>] (id: main.default.customers_source)"])
+		assert.True(t, ic.testEmits["databricks_catalog[<unknown>] (id: lakebase_storage)"])
+	})
+}
@@ -0,0 +1,103 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	mlflowExperimentTypeTag  = "mlflow.experimentType"
+	mlflowDefaultArtifactDir = "dbfs:/databricks/mlflow-tracking/"
+)
+
+// isNotebookExperiment returns true for experiments that are created implicitly for notebooks. They are
+// managed together with notebooks, and can't be created via API
+func isNotebookExperiment(experiment ml.Experiment) bool {
+	for _, tag := range experiment.Tags {
+		if tag.Key == mlflowExperimentTypeTag {
+			return tag.Value == "NOTEBOOK"
+		}
+	}
+	return false
+}
+
+func listMlflowExperiments(ic *importContext) error {
+	experiments, err := ic.workspaceClient.Experiments.ListExperimentsAll(ic.Context, ml.ListExperimentsRequest{})
+	if err != nil {
+		return err
+	}
+	for offset, experiment := range experiments {
+		if isNotebookExperiment(experiment) {
+			log.Printf("[DEBUG] skipping notebook experiment %s", experiment.Name)
+			continue
+		}
+		ic.EmitIfUpdatedAfterMillisAndNameMatches(&resource{
+			Resource: "databricks_mlflow_experiment",
+			ID:       experiment.ExperimentId,
+		}, experiment.Name, experiment.LastUpdateTime, fmt.Sprintf("MLflow experiment '%s'", experiment.Name))
+		if offset%50 == 0 {
+			log.Printf("[INFO] Scanned %d of %d MLflow experiments", offset+1, len(experiments))
+		}
+	}
+	return nil
+}
+
+func mlflowExperimentName(ic *importContext, d *schema.ResourceData) string {
+	name := maybeStripWorkspacePrefix(d.Get("name").(string))
+	if name == "" {
+		return "experiment_" + d.Id()
+	}
+	return nameNormalizationRegex.ReplaceAllString(strings.TrimPrefix(name, "/"), "_") + "_" + d.Id()
+}
+
+func importMlflowExperiment(ic *importContext, r *resource) error {
+	path := maybeStripWorkspacePrefix(r.Data.Get("name").(string))
+	ic.emitUserOrServicePrincipalForPath(path, "/Users")
+	if idx := strings.LastIndex(path, "/"); idx > 0 && ic.isServiceEnabled("directories") {
+		directoryPath := path[:idx]
+		ic.emitDirectoryOrRepo(directoryPath)
+		r.AddExtraData(ParentDirectoryExtraKey, directoryPath)
+	}
+	ic.emitPermissionsIfNotIgnored(r, fmt.Sprintf("/experiments/%s", r.ID),
+		"experiment_"+ic.Importables["databricks_mlflow_experiment"].Name(ic, r.Data))
+	return nil
+}
+
+func shouldOmitForMlflowExperiment(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData, r *resource) bool {
+	if pathString == "artifact_location" {
+		// the default location is generated from the experiment ID, so it can't be used in another workspace
+		location := d.Get(pathString).(string)
+		return location == "" || strings.HasPrefix(location, mlflowDefaultArtifactDir)
+	}
+	return defaultShouldOmitFieldFunc(ic, pathString, as, d, r)
+}
+
+func listMlflowModels(ic *importContext) error {
+	models, err := ic.workspaceClient.ModelRegistry.ListModelsAll(ic.Context, ml.ListModelsRequest{})
+	if err != nil {
+		return err
+	}
+	for offset, model := range models {
+		ic.EmitIfUpdatedAfterMillisAndNameMatches(&resource{
+			Resource: "databricks_mlflow_model",
+			ID:       model.Name,
+		}, model.Name, model.LastUpdatedTimestamp, fmt.Sprintf("MLflow model '%s'", model.Name))
+		if offset%50 == 0 {
+			log.Printf("[INFO] Scanned %d of %d MLflow models", offset+1, len(models))
+		}
+	}
+	return nil
+}
+
+func importMlflowModel(ic *importContext, r *resource) error {
+	modelId := r.Data.Get("registered_model_id").(string)
+	if modelId != "" {
+		ic.emitPermissionsIfNotIgnored(r, fmt.Sprintf("/registered-models/%s", modelId),
+			"mlflow_model_"+ic.Importables["databricks_mlflow_model"].Name(ic, r.Data))
+	}
+	return nil
+}
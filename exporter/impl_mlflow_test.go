@@ -0,0 +1,161 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMlflowExperimentName(t *testing.T) {
+	ic := importContextForTest()
+	d := ic.Resources["databricks_mlflow_experiment"].TestResourceData()
+	d.SetId("123")
+	assert.Equal(t, "experiment_123", resourcesMap["databricks_mlflow_experiment"].Name(ic, d))
+	d.Set("name", "/Workspace/Shared/Test Experiment")
+	assert.Equal(t, "Shared_Test_Experiment_123", resourcesMap["databricks_mlflow_experiment"].Name(ic, d))
+}
+
+func TestShouldOmitForMlflowExperiment(t *testing.T) {
+	ic := importContextForTest()
+	d := ic.Resources["databricks_mlflow_experiment"].TestResourceData()
+	d.SetId("123")
+	scm := ic.Resources["databricks_mlflow_experiment"].Schema
+	omit := resourcesMap["databricks_mlflow_experiment"].ShouldOmitField
+	d.Set("artifact_location", "dbfs:/databricks/mlflow-tracking/123")
+	assert.True(t, omit(ic, "artifact_location", scm["artifact_location"], d, nil))
+	d.Set("artifact_location", "s3://bucket/experiments/123")
+	assert.False(t, omit(ic, "artifact_location", scm["artifact_location"], d, nil))
+}
+
+func TestMlflowExperimentsAndModelsExport(t *testing.T) {
+	experiment := ml.Experiment{
+		ExperimentId:     "123",
+		Name:             "/Shared/Test Experiment",
+		ArtifactLocation: "s3://bucket/experiments/123",
+		LifecycleStage:   "active",
+	}
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/preview/scim/v2/Me",
+			Response: scim.User{
+				UserName: "admin@domain.com",
+				Groups:   []scim.ComplexValue{{Display: "admins"}},
+			},
+		},
+		noCurrentMetastoreAttached,
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/experiments/list?",
+			Response: ml.ListExperimentsResponse{
+				Experiments: []ml.Experiment{
+					experiment,
+					{
+						ExperimentId: "456",
+						Name:         "/Users/user@domain.com/Notebook",
+						Tags: []ml.ExperimentTag{
+							{Key: "mlflow.experimentType", Value: "NOTEBOOK"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/experiments/get?experiment_id=123",
+			Response: ml.GetExperimentResponse{
+				Experiment: &experiment,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/experiments/123?",
+			Response: iam.ObjectPermissions{
+				ObjectId:   "/experiments/123",
+				ObjectType: "mlflowExperiment",
+				AccessControlList: []iam.AccessControlResponse{
+					{
+						GroupName:      "data-scientists",
+						AllPermissions: []iam.Permission{{PermissionLevel: "CAN_EDIT"}},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/registered-models/list?",
+			Response: ml.ListModelsResponse{
+				RegisteredModels: []ml.Model{
+					{Name: "test-model"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/mlflow/databricks/registered-models/get?name=test-model",
+			Response: ml.GetModelResponse{
+				RegisteredModelDatabricks: &ml.ModelDatabricks{
+					Id:          "abc123",
+					Name:        "test-model",
+					Description: "Test model",
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/permissions/registered-models/abc123?",
+			Response: iam.ObjectPermissions{
+				ObjectId:   "/registered-models/abc123",
+				ObjectType: "registered-model",
+				AccessControlList: []iam.AccessControlResponse{
+					{
+						GroupName:      "data-scientists",
+						AllPermissions: []iam.Permission{{PermissionLevel: "CAN_READ"}},
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+		defer os.RemoveAll(tmpDir)
+
+		ic := newImportContext(client)
+		ic.noFormat = true
+		ic.Directory = tmpDir
+		ic.enableListing("mlflow-experiments,mlflow-models")
+		ic.enableServices("mlflow-experiments,mlflow-models,access")
+
+		err := ic.Run()
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(tmpDir + "/mlflow-experiments.tf")
+		require.NoError(t, err)
+		contentStr := string(content)
+		assert.Contains(t, contentStr, `resource "databricks_mlflow_experiment" "shared_test_experiment_123"`)
+		assert.Contains(t, contentStr, `artifact_location = "s3://bucket/experiments/123"`)
+		assert.NotContains(t, contentStr, "Notebook")
+
+		content, err = os.ReadFile(tmpDir + "/mlflow-models.tf")
+		require.NoError(t, err)
+		contentStr = string(content)
+		assert.Contains(t, contentStr, `resource "databricks_mlflow_model" "test_model"`)
+		assert.Contains(t, contentStr, `description = "Test model"`)
+
+		content, err = os.ReadFile(tmpDir + "/access.tf")
+		require.NoError(t, err)
+		contentStr = string(content)
+		assert.Contains(t, contentStr, `experiment_id = databricks_mlflow_experiment.shared_test_experiment_123.id`)
+		assert.Contains(t, contentStr,
+			`registered_model_id = databricks_mlflow_model.test_model.registered_model_id`)
+	})
+}
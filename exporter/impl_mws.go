@@ -28,7 +28,7 @@ func listMwsCredentials(ic *importContext) error {
 				cred.CredentialsName, cred.CreationTime, updatedSinceMs)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_credentials",
 			ID:       ic.accountClient.Config.AccountID + "/" + cred.CredentialsId,
 			Name:     cred.CredentialsName,
@@ -56,7 +56,7 @@ func listMwsStorageConfigurations(ic *importContext) error {
 				sc.StorageConfigurationName, sc.CreationTime, updatedSinceMs)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_storage_configurations",
 			ID:       ic.accountClient.Config.AccountID + "/" + sc.StorageConfigurationId,
 			Name:     sc.StorageConfigurationName,
@@ -78,7 +78,7 @@ func listMwsVpcEndpoints(ic *importContext) error {
 			log.Printf("[INFO] Skipping mws_vpc_endpoint %s because it doesn't match %s", ep.VpcEndpointName, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_vpc_endpoint",
 			ID:       ic.accountClient.Config.AccountID + "/" + ep.VpcEndpointId,
 		})
@@ -100,7 +100,7 @@ func listMwsPrivateAccessSettings(ic *importContext) error {
 			log.Printf("[INFO] Skipping mws_private_access_settings %s because it doesn't match %s", ps.PrivateAccessSettingsName, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_private_access_settings",
 			ID:       ic.accountClient.Config.AccountID + "/" + ps.PrivateAccessSettingsId,
 			Name:     ps.PrivateAccessSettingsName,
@@ -137,7 +137,7 @@ func listMwsCustomerManagedKeys(ic *importContext) error {
 				kms.CustomerManagedKeyId, kms.CreationTime, updatedSinceMs)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_customer_managed_keys",
 			ID:       ic.accountClient.Config.AccountID + "/" + kms.CustomerManagedKeyId,
 			Name:     kms.CustomerManagedKeyId,
@@ -159,7 +159,7 @@ func listMwsNetworks(ic *importContext) error {
 			log.Printf("[INFO] Skipping mws_networks %s because it doesn't match %s", network.NetworkName, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_networks",
 			ID:       ic.accountClient.Config.AccountID + "/" + network.NetworkId,
 			Name:     network.NetworkName,
@@ -216,7 +216,7 @@ func listMwsWorkspaces(ic *importContext) error {
 			continue
 		}
 		wsIdString := strconv.FormatInt(workspace.WorkspaceId, 10)
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_workspaces",
 			ID:       ic.accountClient.Config.AccountID + "/" + wsIdString,
 			Name:     workspace.WorkspaceName + "_" + wsIdString,
@@ -19,7 +19,7 @@ func listCustomAppIntegrations(ic *importContext) error {
 		if !ic.MatchesName(integration.Name) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_custom_app_integration",
 			ID:       integration.IntegrationId,
 		})
@@ -50,7 +50,7 @@ func listAccountFederationPolicies(ic *importContext) error {
 			name = policy.PolicyId
 		}
 
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_account_federation_policy",
 			ID:       policy.PolicyId,
 			Name:     fmt.Sprintf("acc_fed_policy_%s", name),
@@ -22,7 +22,7 @@ func listGroups(ic *importContext) error {
 			log.Printf("[INFO] Group %s doesn't match %s filter", g.DisplayName, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_group",
 			ID:       g.ID,
 		})
@@ -184,7 +184,7 @@ func listUsers(ic *importContext) error {
 	ic.allUsersMutex.RLocker().Unlock()
 	for userName, userScimId := range userMapping {
 		log.Printf("[TRACE] Emitting user %s, SCIM id=%s", userName, userScimId)
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_user",
 			ID:       userScimId,
 		})
@@ -232,7 +232,7 @@ func listServicePrincipals(ic *importContext) error {
 	ic.spsMutex.RLocker().Unlock()
 	for applicationId, appScimId := range spsMapping {
 		log.Printf("[TRACE] Emitting service principal %s, SCIM id=%s", applicationId, appScimId)
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_service_principal",
 			ID:       appScimId,
 		})
@@ -339,7 +339,7 @@ func listWorkspacePermissionAssignments(ic *importContext) error {
 			Name:     nameNormalizationRegex.ReplaceAllString(nm, "_"),
 			Data:     data,
 		}
-		ic.Emit(paResource)
+		ic.EmitListed(paResource)
 		dependsOn := []*resource{paResource}
 		// Emit principals by name
 		if pa.Principal.ServicePrincipalName != "" {
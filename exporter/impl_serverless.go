@@ -21,7 +21,7 @@ func listAccountNetworkPolicies(ic *importContext) error {
 			log.Printf("[INFO] Skipping account_network_policy %s because it doesn't match %s", policy.NetworkPolicyId, ic.match)
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_account_network_policy",
 			ID:       policy.NetworkPolicyId,
 		})
@@ -50,7 +50,7 @@ func listWorkspaceNetworkOptions(ic *importContext) error {
 			continue
 		}
 
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_workspace_network_option",
 			ID:       strconv.FormatInt(workspace.WorkspaceId, 10),
 		})
@@ -127,7 +127,7 @@ func listMwsNetworkConnectivityConfigs(ic *importContext) error {
 			continue
 		}
 		// TODO: technically we can create data directly from the API response
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mws_network_connectivity_config",
 			ID:       nc.AccountId + "/" + nc.NetworkConnectivityConfigId,
 		})
@@ -137,14 +137,14 @@ func listMwsNetworkConnectivityConfigs(ic *importContext) error {
 				resourceId := strings.ReplaceAll(rule.ResourceId, "/subscriptions/", "")
 				resourceId = strings.ReplaceAll(resourceId, "/resourceGroups/", "_")
 				resourceId = strings.ReplaceAll(resourceId, "/providers/Microsoft", "_")
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_mws_ncc_private_endpoint_rule",
 					ID:       nc.NetworkConnectivityConfigId + "/" + rule.RuleId,
 					Name:     nc.Name + "_" + resourceId + "_" + rule.GroupId,
 				})
 			}
 			for _, rule := range nc.EgressConfig.TargetRules.AwsPrivateEndpointRules {
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_mws_ncc_private_endpoint_rule",
 					ID:       nc.NetworkConnectivityConfigId + "/" + rule.RuleId,
 					Name:     nc.Name + "_" + rule.EndpointService,
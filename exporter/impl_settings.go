@@ -31,7 +31,7 @@ func listWorkspaceSettingsV2(ic *importContext) error {
 		return err
 	}
 	for _, setting := range settings {
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_workspace_setting_v2",
 			ID:       setting.Name,
 			Name:     setting.Name,
@@ -59,7 +59,7 @@ func listAccountSettingsV2(ic *importContext) error {
 		return err
 	}
 	for _, setting := range settings {
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_account_setting_v2",
 			ID:       setting.Name,
 			Name:     setting.Name,
@@ -78,7 +78,7 @@ func listNotificationDestinations(ic *importContext) error {
 		if err != nil {
 			return err
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_notification_destination",
 			ID:       n.Id,
 		})
@@ -164,7 +164,7 @@ func listMounts(ic *importContext) error {
 			continue
 		}
 		log.Printf("[INFO] Emitting databricks_mount: %s", source.URL)
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_mount",
 			ID:       mountName,
 			Data: ic.Resources["databricks_mount"].Data(
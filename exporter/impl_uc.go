@@ -23,31 +23,40 @@ func listUcCatalogs(ic *importContext) error {
 	if ic.currentMetastore == nil {
 		return fmt.Errorf("there is no UC metastore information")
 	}
-	it := ic.workspaceClient.Catalogs.List(ic.Context, catalog.ListCatalogsRequest{})
-	for it.HasNext(ic.Context) {
-		v, err := it.Next(ic.Context)
-		if err != nil {
-			return err
-		}
-		switch v.CatalogType {
-		case "MANAGED_CATALOG", "FOREIGN_CATALOG", "DELTASHARING_CATALOG":
-			{
-				name := fmt.Sprintf("%s_%s_%s", v.Name, ic.currentMetastore.Name, v.CatalogType)
-				ic.EmitIfUpdatedAfterMillisAndNameMatches(&resource{
-					Resource: "databricks_catalog",
-					ID:       v.Name,
-					Name:     nameNormalizationRegex.ReplaceAllString(name, "_"),
-				}, v.Name, v.UpdatedAt, fmt.Sprintf("catalog '%s'", v.Name))
-			}
-		default:
-			// TODO: remove this skipping - we need to emit grants for all catalogs, but
-			// we need to convert these catalog types to data sources
-			log.Printf("[INFO] Skipping catalog %s of type %s", v.Name, v.CatalogType)
+	// TODO: emit grants for all catalogs - the catalog types skipped by the listing need to be
+	// converted to data sources
+	catalogs, err := ic.getCatalogs()
+	if err != nil {
+		return err
+	}
+	for _, v := range catalogs {
+		if !tf_uc.IsListedCatalog(v) {
+			continue
 		}
+		name := fmt.Sprintf("%s_%s_%s", v.Name, ic.currentMetastore.Name, v.CatalogType)
+		ic.EmitIfUpdatedAfterMillisAndNameMatches(&resource{
+			Resource: "databricks_catalog",
+			ID:       v.Name,
+			Name:     nameNormalizationRegex.ReplaceAllString(name, "_"),
+		}, v.Name, v.UpdatedAt, fmt.Sprintf("catalog '%s'", v.Name))
 	}
 	return nil
 }
 
+// getCatalogs returns cached list of all catalogs, that is shared by listings of different types of catalogs
+func (ic *importContext) getCatalogs() ([]catalog.CatalogInfo, error) {
+	ic.catalogsMutex.Lock()
+	defer ic.catalogsMutex.Unlock()
+	if ic.allCatalogs == nil {
+		catalogs, err := ic.workspaceClient.Catalogs.ListAll(ic.Context, catalog.ListCatalogsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		ic.allCatalogs = append([]catalog.CatalogInfo{}, catalogs...)
+	}
+	return ic.allCatalogs, nil
+}
+
 func importUcCatalog(ic *importContext, r *resource) error {
 	var cat catalog.CatalogInfo
 	s := ic.Resources["databricks_catalog"].Schema
@@ -68,16 +77,10 @@ func importUcCatalog(ic *importContext, r *resource) error {
 	} else if cat.ShareName == "" {
 		// TODO: We need to be careful here if we add more catalog types... Really we need to have CatalogType in resource
 		if ic.isServiceInListing("uc-schemas") {
-			ignoredSchemas := []string{"information_schema"}
-			it := ic.workspaceClient.Schemas.List(ic.Context, catalog.ListSchemasRequest{CatalogName: r.ID})
-			for it.HasNext(ic.Context) {
-				schema, err := it.Next(ic.Context)
+			for schema, err := range tf_uc.ListSchemas(ic.Context, ic.workspaceClient, r.ID) {
 				if err != nil {
 					return err
 				}
-				if schema.CatalogType != "MANAGED_CATALOG" || slices.Contains(ignoredSchemas, schema.Name) {
-					continue
-				}
 				ic.EmitIfUpdatedAfterMillis(&resource{
 					Resource:  "databricks_schema",
 					ID:        schema.FullName,
@@ -150,7 +153,9 @@ func importUcSchema(ic *importContext, r *resource) error {
 	isTablesListingEnabled := ic.isServiceInListing("uc-tables")
 	isOnlineTablesListingEnabled := ic.isServiceInListing("uc-online-tables")
 	isVectorSearchListingEnabled := ic.isServiceInListing("vector-search")
-	if isTablesListingEnabled || isOnlineTablesListingEnabled || isVectorSearchListingEnabled {
+	isSyncedTablesListingEnabled := ic.isServiceInListing("lakebase")
+	if isTablesListingEnabled || isOnlineTablesListingEnabled || isVectorSearchListingEnabled ||
+		isSyncedTablesListingEnabled {
 		it := ic.workspaceClient.Tables.List(ic.Context, catalog.ListTablesRequest{
 			CatalogName: catalogName,
 			SchemaName:  schemaName,
@@ -160,8 +165,8 @@ func importUcSchema(ic *importContext, r *resource) error {
 			if err != nil {
 				return err // TODO: should we continue?
 			}
-			switch table.TableType {
-			case "MANAGED", "EXTERNAL", "VIEW":
+			switch {
+			case tf_uc.IsSqlTableType(table.TableType):
 				if isTablesListingEnabled {
 					ic.EmitIfUpdatedAfterMillis(&resource{
 						Resource:  "databricks_sql_table",
@@ -169,7 +174,7 @@ func importUcSchema(ic *importContext, r *resource) error {
 						DependsOn: dependsOn,
 					}, table.UpdatedAt, fmt.Sprintf("table '%s'", table.FullName))
 				}
-			case "FOREIGN":
+			case table.TableType == "FOREIGN":
 				// TODO: it's better to use SecurableKind if it will be added to the Go SDK
 				switch table.DataSourceFormat {
 				case "VECTOR_INDEX_FORMAT":
@@ -187,6 +192,13 @@ func importUcSchema(ic *importContext, r *resource) error {
 							DependsOn: dependsOn,
 						}, table.UpdatedAt, fmt.Sprintf("table '%s'", table.FullName))
 					}
+				case "DATABRICKS_ROW_STORE_FORMAT":
+					if isSyncedTablesListingEnabled {
+						ic.Emit(&resource{
+							Resource: "databricks_postgres_synced_table",
+							ID:       "synced_tables/" + table.FullName,
+						})
+					}
 				default:
 					log.Printf("[DEBUG] Skipping foreign table %s of format %s", table.FullName, table.DataSourceFormat)
 				}
@@ -673,7 +685,7 @@ func listSystemSchemas(ic *importContext) error {
 						"schema":       v.Schema,
 					},
 				})
-			ic.Emit(&resource{
+			ic.EmitListed(&resource{
 				Resource: "databricks_system_schema",
 				ID:       id,
 				Data:     data,
@@ -694,7 +706,7 @@ func listArtifactAllowLists(ic *importContext) error {
 	for _, v := range artifactTypes {
 		id := fmt.Sprintf("%s|%s", ic.currentMetastore.MetastoreId, v)
 		name := fmt.Sprintf("%s_%s_%s", v, ic.currentMetastore.Name, ic.currentMetastore.MetastoreId[:8])
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_artifact_allowlist",
 			ID:       id,
 			Name:     nameNormalizationRegex.ReplaceAllString(name, "_"),
@@ -714,7 +726,7 @@ func listTagPolicies(ic *importContext) error {
 		if !ic.MatchesName(tagPolicy.TagKey) {
 			continue
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_tag_policy",
 			ID:       tagPolicy.TagKey,
 		})
@@ -737,6 +749,7 @@ func importSqlTable(ic *importContext, r *resource) error {
 
 	// Emit RFA access request destinations if configured
 	ic.emitRfaAccessRequestDestinations("TABLE", tableFullName)
+	ic.emitQualityMonitor(tableFullName)
 
 	return nil
 }
@@ -840,7 +853,7 @@ func listDataQualityMonitors(ic *importContext) error {
 		if err != nil {
 			return err
 		}
-		ic.Emit(&resource{
+		ic.EmitListed(&resource{
 			Resource: "databricks_data_quality_monitor",
 			ID:       fmt.Sprintf("%s,%s", monitor.ObjectType, monitor.ObjectId),
 		})
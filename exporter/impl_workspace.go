@@ -116,7 +116,7 @@ func listRepos(ic *importContext) error {
 			continue
 		}
 		if repo.Url != "" {
-			ic.Emit(&resource{
+			ic.EmitListed(&resource{
 				Resource: "databricks_repo",
 				ID:       strconv.FormatInt(repo.Id, 10),
 			})
@@ -144,6 +144,46 @@ func importRepo(ic *importContext, r *resource) error {
 	return nil
 }
 
+// getGitCredentials returns cached list of Git credentials of the current user
+func (ic *importContext) getGitCredentials() []sdk_workspace.CredentialInfo {
+	ic.gitCredentialsMutex.Lock()
+	defer ic.gitCredentialsMutex.Unlock()
+	if ic.gitCredentials == nil {
+		credentials, err := ic.workspaceClient.GitCredentials.ListAll(ic.Context, sdk_workspace.ListCredentialsRequest{})
+		if err != nil {
+			log.Printf("[ERROR] Can't list Git credentials: %v", err)
+			credentials = []sdk_workspace.CredentialInfo{}
+		}
+		ic.gitCredentials = credentials
+	}
+	return ic.gitCredentials
+}
+
+// listGitCredentials emits Git credentials of the current identity. They aren't emitted as dependencies of jobs
+// or repos, because the API doesn't return credentials of other users, like owners of these objects.
+func listGitCredentials(ic *importContext) error {
+	for _, credential := range ic.getGitCredentials() {
+		ic.EmitListed(&resource{
+			Resource: "databricks_git_credential",
+			ID:       strconv.FormatInt(credential.CredentialId, 10),
+		})
+	}
+	return nil
+}
+
+func importGitCredential(ic *importContext, r *resource) error {
+	// Read returns only Git provider & username, so we take the rest from the list of credentials
+	for _, credential := range ic.getGitCredentials() {
+		if strconv.FormatInt(credential.CredentialId, 10) != r.ID {
+			continue
+		}
+		r.Data.Set("git_email", credential.GitEmail)
+		r.Data.Set("name", credential.Name)
+		r.Data.Set("is_default_for_provider", credential.IsDefaultForProvider)
+	}
+	return nil
+}
+
 func importWorkspaceFile(ic *importContext, r *resource) error {
 	ic.emitUserOrServicePrincipalForPath(r.ID, "/Users")
 	reader, err := ic.workspaceClient.Workspace.Download(ic.Context, r.ID,
@@ -334,3 +334,69 @@ func TestDirectoryGeneration(t *testing.T) {
 		}`), getGeneratedFile(ic, "directories"))
 	})
 }
+
+var gitCredentialsFixture = qa.HTTPFixture{
+	Method:       "GET",
+	ReuseRequest: true,
+	Resource:     "/api/2.0/git-credentials?",
+	Response: sdk_workspace.ListCredentialsResponse{
+		Credentials: []sdk_workspace.CredentialInfo{
+			{
+				CredentialId: 1234,
+				GitProvider:  "gitHub",
+				GitUsername:  "user",
+				GitEmail:     "user@domain.com",
+				Name:         "github",
+			},
+			{
+				CredentialId: 5678,
+				GitProvider:  "gitLab",
+				GitUsername:  "user",
+			},
+		},
+	},
+}
+
+func TestGitCredentialGeneration(t *testing.T) {
+	testGenerate(t, []qa.HTTPFixture{
+		gitCredentialsFixture,
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/git-credentials/1234?",
+			Response: sdk_workspace.GetCredentialsResponse{
+				CredentialId: 1234,
+				GitProvider:  "gitHub",
+				GitUsername:  "user",
+			},
+		},
+	}, "repos", false, func(ic *importContext) {
+		ic.Emit(&resource{
+			Resource: "databricks_git_credential",
+			ID:       "1234",
+		})
+		ic.waitGroup.Wait()
+		ic.closeImportChannels()
+		ic.generateAndWriteResources(nil)
+		assert.Equal(t, commands.TrimLeadingWhitespace(`
+		resource "databricks_git_credential" "git_credential_github_1234" {
+		  personal_access_token = var.personal_access_token_git_credential_github_1234
+		  name                  = "github"
+		  git_username          = "user"
+		  git_provider          = "gitHub"
+		  git_email             = "user@domain.com"
+		}`), getGeneratedFile(ic, "repos"))
+	})
+}
+
+func TestListGitCredentials(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{gitCredentialsFixture},
+		func(ctx context.Context, client *common.DatabricksClient) {
+			ic := importContextForTestWithClient(ctx, client)
+			ic.enableServices("repos")
+			assert.NoError(t, listGitCredentials(ic))
+			assert.Equal(t, map[string]bool{
+				"databricks_git_credential[<unknown>] (id: 1234)": true,
+				"databricks_git_credential[<unknown>] (id: 5678)": true,
+			}, ic.testEmits)
+		})
+}
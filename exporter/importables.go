@@ -344,7 +344,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		},
 		List: func(ic *importContext) error {
 			if ic.meAdmin {
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_permissions",
 					ID:       "/authorization/tokens",
 					Name:     "tokens_usage",
@@ -363,12 +363,13 @@ var resourcesMap map[string]importable = map[string]importable{
 			{Path: "sql_dashboard_id", Resource: "databricks_sql_dashboard"},
 			{Path: "sql_endpoint_id", Resource: "databricks_sql_endpoint"},
 			{Path: "dashboard_id", Resource: "databricks_dashboard"},
-			{Path: "registered_model_id", Resource: "databricks_mlflow_model"},
+			{Path: "registered_model_id", Resource: "databricks_mlflow_model", Match: "registered_model_id"},
 			{Path: "experiment_id", Resource: "databricks_mlflow_experiment"},
 			{Path: "repo_id", Resource: "databricks_repo"},
 			{Path: "vector_search_endpoint_id", Resource: "databricks_vector_search_endpoint", Match: "endpoint_id"},
 			{Path: "serving_endpoint_id", Resource: "databricks_model_serving", Match: "serving_endpoint_id"},
 			{Path: "database_instance_name", Resource: "databricks_database_instance", Match: "name"},
+			{Path: "database_project_name", Resource: "databricks_postgres_project", Match: "project_id"},
 			{Path: "app_name", Resource: "databricks_app", Match: "name"},
 			// TODO: can we fill _path component for it, and then match on user/SP home instead?
 			{Path: "directory_id", Resource: "databricks_directory", Match: "object_id"},
@@ -439,7 +440,7 @@ var resourcesMap map[string]importable = map[string]importable{
 					log.Printf("[INFO] Secret scope %s doesn't match %s filter", scope.Name, ic.match)
 					continue
 				}
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_secret_scope",
 					ID:       scope.Name,
 				})
@@ -619,6 +620,26 @@ var resourcesMap map[string]importable = map[string]importable{
 				MatchType: MatchPrefix, SearchValueTransformFunc: appendEndingSlashToDirName},
 		},
 	},
+	"databricks_git_credential": {
+		WorkspaceLevel: true,
+		Service:        "repos",
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return "git_credential_" + nameNormalizationRegex.ReplaceAllString(d.Get("git_provider").(string), "_") +
+				"_" + d.Id()
+		},
+		List:   listGitCredentials,
+		Import: importGitCredential,
+		ShouldOmitField: func(ic *importContext, pathString string, as *schema.Schema, d *schema.ResourceData, r *resource) bool {
+			// API doesn't return personal access token, so we generate a variable for it
+			if pathString == "personal_access_token" {
+				return false
+			}
+			return defaultShouldOmitFieldFunc(ic, pathString, as, d, r)
+		},
+		Depends: []reference{
+			{Path: "personal_access_token", Variable: true},
+		},
+	},
 	"databricks_workspace_conf": {
 		WorkspaceLevel: true,
 		Service:        "wsconf",
@@ -627,7 +648,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		},
 		List: func(ic *importContext) error {
 			if ic.meAdmin {
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_workspace_conf",
 					ID:       globalWorkspaceConfName,
 				})
@@ -788,7 +809,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		},
 		List: func(ic *importContext) error {
 			if ic.meAdmin {
-				ic.Emit(&resource{
+				ic.EmitListed(&resource{
 					Resource: "databricks_sql_global_config",
 					ID:       tf_sql.GlobalSqlConfigResourceID,
 				})
@@ -1422,6 +1443,117 @@ var resourcesMap map[string]importable = map[string]importable{
 		ShouldOmitFieldUnified: shouldOmitWithEffectiveFields,
 		Ignore:                 generateIgnoreObjectWithEmptyAttributeValue("databricks_database_instance", "name"),
 	},
+	"databricks_postgres_project": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		List:                   listPostgresProjects,
+		Import:                 importPostgresProject,
+		Depends: []reference{
+			{Path: "spec.budget_policy_id", Resource: "databricks_budget_policy", Match: "policy_id"},
+		},
+	},
+	"databricks_postgres_branch": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		Import:                 importPostgresBranch,
+		Depends: []reference{
+			{Path: "parent", Resource: "databricks_postgres_project", Match: "name"},
+			{Path: "spec.source_branch", Resource: "databricks_postgres_branch", Match: "name"},
+		},
+	},
+	"databricks_postgres_endpoint": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		Import:                 importPostgresEndpoint,
+		Depends: []reference{
+			{Path: "parent", Resource: "databricks_postgres_branch", Match: "name"},
+		},
+	},
+	"databricks_postgres_role": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		Import:                 importPostgresRole,
+		Depends: []reference{
+			{Path: "parent", Resource: "databricks_postgres_branch", Match: "name"},
+		},
+	},
+	"databricks_postgres_database": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		Import:                 importPostgresDatabase,
+		Depends: []reference{
+			{Path: "parent", Resource: "databricks_postgres_branch", Match: "name"},
+			{Path: "spec.role", Resource: "databricks_postgres_role", Match: "name"},
+		},
+	},
+	"databricks_postgres_catalog": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		List:                   listPostgresCatalogs,
+		Import:                 importPostgresCatalog,
+		Depends: []reference{
+			{Path: "spec.branch", Resource: "databricks_postgres_branch", Match: "name"},
+		},
+	},
+	"databricks_postgres_synced_table": {
+		WorkspaceLevel:         true,
+		PluginFramework:        true,
+		Service:                "lakebase",
+		NameUnified:            postgresNameUnified,
+		ShouldOmitFieldUnified: shouldOmitForPostgres,
+		Import:                 importPostgresSyncedTable,
+		Depends: []reference{
+			{Path: "spec.branch", Resource: "databricks_postgres_branch", Match: "name"},
+			{Path: "spec.source_table_full_name", Resource: "databricks_sql_table"},
+			{Path: "spec.new_pipeline_spec.storage_catalog", Resource: "databricks_catalog"},
+			{Path: "spec.new_pipeline_spec.budget_policy_id", Resource: "databricks_budget_policy", Match: "policy_id"},
+		},
+	},
+	"databricks_mlflow_experiment": {
+		WorkspaceLevel:  true,
+		Service:         "mlflow-experiments",
+		Name:            mlflowExperimentName,
+		List:            listMlflowExperiments,
+		Import:          importMlflowExperiment,
+		ShouldOmitField: shouldOmitForMlflowExperiment,
+		Depends: []reference{
+			{Path: "name", Resource: "databricks_directory", MatchType: MatchLongestPrefix,
+				SearchValueTransformFunc: appendEndingSlashToDirName, ExtraLookupKey: ParentDirectoryExtraKey},
+			{Path: "name", Resource: "databricks_user", Match: "home",
+				MatchType: MatchPrefix, SearchValueTransformFunc: appendEndingSlashToDirName},
+			{Path: "name", Resource: "databricks_service_principal", Match: "home",
+				MatchType: MatchPrefix, SearchValueTransformFunc: appendEndingSlashToDirName},
+			{Path: "artifact_location", Resource: "databricks_external_location",
+				Match: "url", MatchType: MatchLongestPrefix},
+		},
+	},
+	"databricks_mlflow_model": {
+		WorkspaceLevel: true,
+		Service:        "mlflow-models",
+		Name: func(ic *importContext, d *schema.ResourceData) string {
+			return nameNormalizationRegex.ReplaceAllString(d.Id(), "_")
+		},
+		List:   listMlflowModels,
+		Import: importMlflowModel,
+	},
 	"databricks_mlflow_webhook": {
 		WorkspaceLevel: true,
 		Service:        "mlflow-webhooks",
@@ -1453,6 +1585,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		Depends: []reference{
 			{Path: "job_spec.job_id", Resource: "databricks_job"},
 			{Path: "job_spec.access_token", Variable: true},
+			{Path: "model_name", Resource: "databricks_mlflow_model"},
 			// We can enable it, but we don't know if authorization is set or not because API doesn't return it
 			// {Path: "http_url_spec.authorization", Variable: true},
 		},
@@ -1463,7 +1596,7 @@ var resourcesMap map[string]importable = map[string]importable{
 		List: func(ic *importContext) error {
 			accountId := ic.Client.Config.AccountID
 			// emit default ruleset
-			ic.Emit(&resource{
+			ic.EmitListed(&resource{
 				Resource: "databricks_access_control_rule_set",
 				ID:       fmt.Sprintf("accounts/%s/ruleSets/default", accountId),
 			})
@@ -1698,6 +1831,28 @@ var resourcesMap map[string]importable = map[string]importable{
 				Resource: "databricks_user", Match: "user_name", MatchType: MatchCaseInsensitive},
 		},
 	},
+	"databricks_quality_monitor": {
+		WorkspaceLevel:  true,
+		PluginFramework: true,
+		Service:         "dq",
+		NameUnified: func(ic *importContext, wrapper ResourceDataWrapper) string {
+			return nameNormalizationRegex.ReplaceAllString(wrapper.Id(), "_")
+		},
+		// Legacy monitors are emitted from tables because there is no API to list them
+		Import: importQualityMonitor,
+		Depends: []reference{
+			{Path: "table_name", Resource: "databricks_sql_table"},
+			{Path: "baseline_table_name", Resource: "databricks_sql_table"},
+			{Path: "output_schema_name", Resource: "databricks_schema"},
+			{Path: "assets_dir", Resource: "databricks_directory"},
+			{Path: "assets_dir", Resource: "databricks_user", Match: "home"},
+			{Path: "assets_dir", Resource: "databricks_service_principal", Match: "home"},
+			{Path: "notifications.on_failure.email_addresses", Resource: "databricks_user",
+				Match: "user_name", MatchType: MatchCaseInsensitive},
+			{Path: "notifications.on_new_classification_tag_detected.email_addresses", Resource: "databricks_user",
+				Match: "user_name", MatchType: MatchCaseInsensitive},
+		},
+	},
 	"databricks_quality_monitor_v2": {
 		WorkspaceLevel:  true,
 		PluginFramework: true,
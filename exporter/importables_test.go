@@ -62,6 +62,10 @@ func importContextForTest() *importContext {
 		exportDeletedUsersAssets:  false,
 		ignoredResources:          map[string]struct{}{},
 		deletedResources:          map[string]struct{}{},
+		oldResourceNames:          map[string]string{},
+		workspaceMoves:            map[string]string{},
+		movedResources:            map[string]string{},
+		generatedResources:        map[string]struct{}{},
 		State:                     newStateApproximation(supportedResources),
 		emittedUsers:              map[string]struct{}{},
 		userOrSpDirectories:       map[string]bool{},
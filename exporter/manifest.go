@@ -0,0 +1,213 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+const (
+	manifestJsonFileName = "exporter-manifest.json"
+	manifestDotFileName  = "exporter-manifest.dot"
+)
+
+// manifestReference describes a reference from an attribute of the exported resource to another resource.
+type manifestReference struct {
+	// Path to the attribute, like, `task.0.existing_cluster_id`
+	Path string `json:"path"`
+	// Value of the attribute in the source object
+	Value string `json:"value"`
+	// Address of the referenced resource, like, `databricks_cluster.test`. Empty for unresolved references
+	Target string `json:"target,omitempty"`
+	// Resource types that were searched for the unresolved references
+	Candidates []string `json:"candidates,omitempty"`
+}
+
+// manifestEntry describes a single exported resource
+type manifestEntry struct {
+	Address    string              `json:"address"`
+	Resource   string              `json:"resource"`
+	Name       string              `json:"name"`
+	Mode       string              `json:"mode"`
+	ID         string              `json:"id"`
+	Service    string              `json:"service"`
+	References []manifestReference `json:"references"`
+	Unresolved []manifestReference `json:"unresolved_references"`
+
+	generated bool
+}
+
+type manifestContent struct {
+	Resources []*manifestEntry `json:"resources"`
+}
+
+// exportManifest collects information about generated resources & their references. It's filled by multiple
+// resource generators in parallel, so all access is protected by mutex.
+type exportManifest struct {
+	mutex   sync.Mutex
+	entries map[string]*manifestEntry
+}
+
+func newExportManifest() *exportManifest {
+	return &exportManifest{
+		entries: map[string]*manifestEntry{},
+	}
+}
+
+func resourceAddress(r *resource) string {
+	if r.Mode == "data" {
+		return "data." + generateResourceName(r.Resource, r.Name)
+	}
+	return generateResourceName(r.Resource, r.Name)
+}
+
+// traversalAddress returns address of the resource from the traversal generated by genTraversalTokens
+func traversalAddress(traversal hcl.Traversal) string {
+	parts := make([]string, 0, len(traversal))
+	// the last element is the attribute name
+	for _, t := range traversal[:len(traversal)-1] {
+		switch tt := t.(type) {
+		case hcl.TraverseRoot:
+			parts = append(parts, tt.Name)
+		case hcl.TraverseAttr:
+			parts = append(parts, tt.Name)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func (m *exportManifest) entry(r *resource) *manifestEntry {
+	address := resourceAddress(r)
+	e, exists := m.entries[address]
+	if !exists {
+		e = &manifestEntry{
+			Address:    address,
+			Resource:   r.Resource,
+			Name:       r.Name,
+			References: []manifestReference{},
+			Unresolved: []manifestReference{},
+		}
+		m.entries[address] = e
+	}
+	return e
+}
+
+func (m *exportManifest) addResource(r *resource, service string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e := m.entry(r)
+	e.ID = r.ID
+	e.Service = service
+	e.Mode = "managed"
+	if r.Mode == "data" {
+		e.Mode = "data"
+	}
+	e.generated = true
+}
+
+func (m *exportManifest) addReference(r *resource, ref manifestReference) {
+	if m == nil || r == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e := m.entry(r)
+	if ref.Target == "" {
+		e.Unresolved = append(e.Unresolved, ref)
+	} else {
+		e.References = append(e.References, ref)
+	}
+}
+
+// content returns generated resources sorted by address, with references sorted by path
+func (m *exportManifest) content() manifestContent {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	content := manifestContent{Resources: []*manifestEntry{}}
+	for _, address := range slices.Sorted(maps.Keys(m.entries)) {
+		e := m.entries[address]
+		if !e.generated {
+			continue
+		}
+		for _, refs := range [][]manifestReference{e.References, e.Unresolved} {
+			slices.SortFunc(refs, func(a, b manifestReference) int {
+				return strings.Compare(a.Path+"\x00"+a.Target, b.Path+"\x00"+b.Target)
+			})
+		}
+		content.Resources = append(content.Resources, e)
+	}
+	return content
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// dot generates Graphviz representation of the manifest, with resources grouped by service
+func (c manifestContent) dot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph exporter {\n  rankdir = \"LR\";\n  node [shape = box];\n")
+	byService := map[string][]*manifestEntry{}
+	for _, e := range c.Resources {
+		byService[e.Service] = append(byService[e.Service], e)
+	}
+	for _, service := range slices.Sorted(maps.Keys(byService)) {
+		sb.WriteString(fmt.Sprintf("  subgraph %s {\n    label = %s;\n",
+			dotQuote("cluster_"+service), dotQuote(service)))
+		for _, e := range byService[service] {
+			sb.WriteString(fmt.Sprintf("    %s [label = %s];\n", dotQuote(e.Address),
+				dotQuote(e.Address+"\n"+e.ID)))
+		}
+		sb.WriteString("  }\n")
+	}
+	for _, e := range c.Resources {
+		for _, ref := range e.References {
+			sb.WriteString(fmt.Sprintf("  %s -> %s [label = %s];\n", dotQuote(e.Address),
+				dotQuote(ref.Target), dotQuote(ref.Path)))
+		}
+		for _, ref := range e.Unresolved {
+			node := "unresolved:" + e.Address + ":" + ref.Path
+			sb.WriteString(fmt.Sprintf("  %s [label = %s, shape = note, style = dashed];\n", dotQuote(node),
+				dotQuote(strings.Join(ref.Candidates, ", ")+"\n"+ref.Value)))
+			sb.WriteString(fmt.Sprintf("  %s -> %s [label = %s, style = dashed];\n", dotQuote(e.Address),
+				dotQuote(node), dotQuote(ref.Path)))
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// writeManifest writes manifest of the generated resources in JSON and Graphviz formats
+func (ic *importContext) writeManifest() error {
+	if ic.manifest == nil {
+		return nil
+	}
+	content := ic.manifest.content()
+	jsonData, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%s/%s", ic.Directory, manifestJsonFileName)
+	if err = os.WriteFile(fileName, jsonData, 0644); err != nil {
+		return err
+	}
+	fileName = fmt.Sprintf("%s/%s", ic.Directory, manifestDotFileName)
+	if err = os.WriteFile(fileName, []byte(content.dot()), 0644); err != nil {
+		return err
+	}
+	log.Printf("[INFO] Wrote manifest for %d resources", len(content.Resources))
+	return nil
+}
@@ -0,0 +1,111 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestManifestReferences(t *testing.T) {
+	state := newStateApproximation([]string{"databricks_cluster", "databricks_notebook", "databricks_repo"})
+	state.Append(resourceApproximation{
+		Type: "databricks_cluster",
+		Name: "shared",
+		Mode: "managed",
+		Instances: []instanceApproximation{
+			{
+				Attributes: map[string]any{
+					"id": "0123-456789-abc",
+				},
+			},
+		}})
+	ic := &importContext{
+		State:    state,
+		manifest: newExportManifest(),
+	}
+	imp := importable{
+		Depends: []reference{
+			{Path: "task.existing_cluster_id", Resource: "databricks_cluster"},
+			{Path: "task.notebook_task.notebook_path", Resource: "databricks_notebook", Match: "path"},
+			{Path: "task.notebook_task.notebook_path", Resource: "databricks_repo", Match: "path",
+				MatchType: MatchPrefix},
+		},
+	}
+	job := &resource{Resource: "databricks_job", ID: "14", Name: "test_14"}
+	tokens := ic.reference(imp, []string{"task", "0", "existing_cluster_id"}, "0123-456789-abc",
+		cty.StringVal("0123-456789-abc"), job)
+	assert.Equal(t, "databricks_cluster.shared.id", string(tokens.Bytes()))
+	tokens = ic.reference(imp, []string{"task", "1", "notebook_task", "notebook_path"}, "/Test",
+		cty.StringVal("/Test"), job)
+	assert.Equal(t, `"/Test"`, string(tokens.Bytes()))
+	// fields without dependencies aren't recorded
+	ic.reference(imp, []string{"name"}, "Test", cty.StringVal("Test"), job)
+
+	// resources that weren't generated aren't included
+	assert.Len(t, ic.manifest.content().Resources, 0)
+	ic.manifest.addResource(job, "jobs")
+
+	content := ic.manifest.content()
+	require.Len(t, content.Resources, 1)
+	entry := content.Resources[0]
+	assert.Equal(t, "databricks_job.test_14", entry.Address)
+	assert.Equal(t, "14", entry.ID)
+	assert.Equal(t, "jobs", entry.Service)
+	assert.Equal(t, "managed", entry.Mode)
+	assert.Equal(t, []manifestReference{
+		{Path: "task.0.existing_cluster_id", Value: "0123-456789-abc", Target: "databricks_cluster.shared"},
+	}, entry.References)
+	assert.Equal(t, []manifestReference{
+		{Path: "task.1.notebook_task.notebook_path", Value: "/Test",
+			Candidates: []string{"databricks_notebook", "databricks_repo"}},
+	}, entry.Unresolved)
+
+	dot := content.dot()
+	assert.Contains(t, dot, `subgraph "cluster_jobs" {`)
+	assert.Contains(t, dot, `"databricks_job.test_14" [label = "databricks_job.test_14\n14"];`)
+	assert.Contains(t, dot, `"databricks_job.test_14" -> "databricks_cluster.shared" [label = "task.0.existing_cluster_id"];`)
+	assert.Contains(t, dot, `"databricks_job.test_14" -> "unresolved:databricks_job.test_14:task.1.notebook_task.notebook_path" [label = "task.1.notebook_task.notebook_path", style = dashed];`)
+}
+
+func TestManifestDataSourceAddress(t *testing.T) {
+	m := newExportManifest()
+	m.addResource(&resource{Resource: "databricks_current_metastore", Name: "this", Mode: "data"}, "uc-metastores")
+	content := m.content()
+	require.Len(t, content.Resources, 1)
+	assert.Equal(t, "data.databricks_current_metastore.this", content.Resources[0].Address)
+	assert.Equal(t, "data", content.Resources[0].Mode)
+}
+
+func TestWriteManifest(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(tmpDir, 0755))
+	defer os.RemoveAll(tmpDir)
+
+	ic := &importContext{Directory: tmpDir}
+	// nothing is written when manifest isn't requested
+	require.NoError(t, ic.writeManifest())
+	_, err := os.Stat(tmpDir + "/" + manifestJsonFileName)
+	assert.True(t, os.IsNotExist(err))
+
+	ic.manifest = newExportManifest()
+	ic.manifest.addResource(&resource{Resource: "databricks_cluster", ID: "abc", Name: "shared"}, "compute")
+	require.NoError(t, ic.writeManifest())
+
+	data, err := os.ReadFile(tmpDir + "/" + manifestJsonFileName)
+	require.NoError(t, err)
+	var content manifestContent
+	require.NoError(t, json.Unmarshal(data, &content))
+	require.Len(t, content.Resources, 1)
+	assert.Equal(t, "databricks_cluster.shared", content.Resources[0].Address)
+	assert.Equal(t, "abc", content.Resources[0].ID)
+
+	data, err = os.ReadFile(tmpDir + "/" + manifestDotFileName)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"databricks_cluster.shared" [label = "databricks_cluster.shared\nabc"];`)
+}
@@ -241,6 +241,8 @@ type resource struct {
 	// If not specified, then we generate a normal resource block, or we can generate a data block if it's set to "data"
 	Mode        string
 	Incremental bool
+	// Set for resources emitted by the listing, only they are checked against the `-filter` expression
+	Listed bool
 	// Actual Terraform data (SDKv2 only)
 	Data *schema.ResourceData
 	// Data wrapper for both SDKv2 and Plugin Framework resources
@@ -291,10 +293,7 @@ func (r *resource) String() string {
 }
 
 func (r *resource) ImportCommand(ic *importContext) string {
-	m := ""
-	if ic.Module != "" {
-		m = ic.Module + "."
-	}
+	m := ic.moduleAddressPrefix(ic.serviceOf(r.Resource))
 	return fmt.Sprintf(`terraform import %s%s.%s "%s"`, m, r.Resource, r.Name, r.ID)
 }
 
@@ -374,6 +373,10 @@ func (r *resource) ImportResource(ic *importContext) {
 	if r.DataWrapper != nil {
 		ic.convertResourceDataCloudAttributes(r.DataWrapper, r.Resource)
 	}
+	if !ic.MatchesFilter(r, ir) && ic.markFilteredOut(r) {
+		log.Printf("[INFO] %s doesn't match filter '%s'", r, ic.filterStr)
+		return
+	}
 	r.Name = ic.ResourceName(r)
 	if ir.Import != nil {
 		err := runWithRetries(func() error {
@@ -0,0 +1,208 @@
+package exporter
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// moduleOutput describes a value that is exported from one service module and passed to other modules
+type moduleOutput struct {
+	// Service module that defines the output
+	Service string
+	// Traversal to the attribute of the resource inside the service module
+	Traversal hcl.Traversal
+}
+
+// splitModulesState tracks the wiring between service modules when `-splitModules` is used. All access is
+// protected by mutex because resources are generated in parallel.
+type splitModulesState struct {
+	// outputs by names of the corresponding variables
+	outputs map[string]moduleOutput
+	// names of variables used by resources of a given service
+	variables map[string]map[string]struct{}
+}
+
+func newSplitModulesState() *splitModulesState {
+	return &splitModulesState{
+		outputs:   map[string]moduleOutput{},
+		variables: map[string]map[string]struct{}{},
+	}
+}
+
+func (ic *importContext) serviceOf(resourceType string) string {
+	return ic.Importables[resourceType].Service
+}
+
+// moduleDirectory returns directory where generated code for a given service is written
+func (ic *importContext) moduleDirectory(service string) string {
+	if ic.splitModules {
+		return fmt.Sprintf("%s/%s", ic.Directory, service)
+	}
+	return ic.Directory
+}
+
+// moduleAddressPrefix returns the prefix of resource addresses in the Terraform state for a given service
+func (ic *importContext) moduleAddressPrefix(service string) string {
+	prefix := ""
+	if ic.Module != "" {
+		prefix = ic.Module + "."
+	}
+	if ic.splitModules {
+		prefix += "module." + service + "."
+	}
+	return prefix
+}
+
+// relativeFilePath returns the path to a generated file as it should be used from the module of a given resource
+func (ic *importContext) relativeFilePath(value string) string {
+	if ic.splitModules {
+		// service modules are generated one level below the directory with files
+		return fmt.Sprintf("${path.module}/../%s", value)
+	}
+	return fmt.Sprintf("${path.module}/%s", value)
+}
+
+// crossModuleTraversal replaces the reference to a resource from another service module with a reference to
+// a variable, and records the output that should be passed into that variable by the root module.
+func (ic *importContext) crossModuleTraversal(origResource *resource, traversal hcl.Traversal) hcl.Traversal {
+	if !ic.splitModules || origResource == nil {
+		return traversal
+	}
+	parts := make([]string, 0, len(traversal))
+	for _, t := range traversal {
+		switch tt := t.(type) {
+		case hcl.TraverseRoot:
+			parts = append(parts, tt.Name)
+		case hcl.TraverseAttr:
+			parts = append(parts, tt.Name)
+		}
+	}
+	targetType := parts[0]
+	if targetType == "data" && len(parts) > 1 {
+		targetType = parts[1]
+	}
+	targetService := ic.serviceOf(targetType)
+	if targetService == ic.serviceOf(origResource.Resource) {
+		return traversal
+	}
+	name := strings.Join(parts, "_")
+	ic.modulesMutex.Lock()
+	ic.modules.outputs[name] = moduleOutput{Service: targetService, Traversal: traversal}
+	ic.modulesMutex.Unlock()
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	}
+}
+
+// addModuleVariables records variables that are used in the generated code of a resource from a given service
+func (ic *importContext) addModuleVariables(service string, code []byte) {
+	if !ic.splitModules {
+		return
+	}
+	tokens, diags := hclsyntax.LexConfig(code, "resource.tf", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		log.Printf("[WARN] can't parse generated code for variables: %s", diags.Error())
+	}
+	ic.modulesMutex.Lock()
+	defer ic.modulesMutex.Unlock()
+	variables, exists := ic.modules.variables[service]
+	if !exists {
+		variables = map[string]struct{}{}
+		ic.modules.variables[service] = variables
+	}
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].Type == hclsyntax.TokenIdent && string(tokens[i].Bytes) == "var" &&
+			tokens[i+1].Type == hclsyntax.TokenDot && tokens[i+2].Type == hclsyntax.TokenIdent {
+			variables[string(tokens[i+2].Bytes)] = struct{}{}
+		}
+	}
+}
+
+func writeHclFile(fileName string, f *hclwrite.File) error {
+	return os.WriteFile(fileName, hclwrite.Format(f.Bytes()), 0644)
+}
+
+// generateModules writes variables, outputs & provider requirements for each of the service modules, and
+// the root module that calls them
+func (ic *importContext) generateModules() error {
+	if !ic.splitModules {
+		return nil
+	}
+	ic.modulesMutex.Lock()
+	defer ic.modulesMutex.Unlock()
+	outputsByService := map[string][]string{}
+	for name, output := range ic.modules.outputs {
+		outputsByService[output.Service] = append(outputsByService[output.Service], name)
+	}
+	root := hclwrite.NewEmptyFile()
+	for _, service := range slices.Sorted(maps.Keys(ic.modules.variables)) {
+		directory := ic.moduleDirectory(service)
+		if _, err := os.Stat(directory); err != nil {
+			log.Printf("[DEBUG] skipping module for service %s without generated resources", service)
+			continue
+		}
+		versions := hclwrite.NewEmptyFile()
+		requiredProviders := versions.Body().AppendNewBlock("terraform", nil).Body().
+			AppendNewBlock("required_providers", nil).Body()
+		requiredProviders.SetAttributeValue("databricks", cty.ObjectVal(map[string]cty.Value{
+			"source": cty.StringVal("databricks/databricks"),
+		}))
+		if err := writeHclFile(directory+"/terraform.tf", versions); err != nil {
+			return err
+		}
+
+		moduleBlock := root.Body().AppendNewBlock("module", []string{service}).Body()
+		moduleBlock.SetAttributeValue("source", cty.StringVal("./"+service))
+		variables := slices.Sorted(maps.Keys(ic.modules.variables[service]))
+		if len(variables) > 0 {
+			vars := hclwrite.NewEmptyFile()
+			for _, name := range variables {
+				block := vars.Body().AppendNewBlock("variable", []string{name}).Body()
+				output, isOutput := ic.modules.outputs[name]
+				if isOutput {
+					block.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("Value of %s from the %s module",
+						string(hclwrite.TokensForTraversal(output.Traversal).Bytes()), output.Service)))
+					moduleBlock.SetAttributeTraversal(name, hcl.Traversal{
+						hcl.TraverseRoot{Name: "module"},
+						hcl.TraverseAttr{Name: output.Service},
+						hcl.TraverseAttr{Name: name},
+					})
+				} else {
+					if desc := ic.variables[name]; desc != "" {
+						block.SetAttributeValue("description", cty.StringVal(desc))
+					}
+					moduleBlock.SetAttributeTraversal(name, hcl.Traversal{
+						hcl.TraverseRoot{Name: "var"},
+						hcl.TraverseAttr{Name: name},
+					})
+				}
+			}
+			if err := writeHclFile(directory+"/variables.tf", vars); err != nil {
+				return err
+			}
+		}
+		if names := outputsByService[service]; len(names) > 0 {
+			outputs := hclwrite.NewEmptyFile()
+			slices.Sort(names)
+			for _, name := range names {
+				block := outputs.Body().AppendNewBlock("output", []string{name}).Body()
+				block.SetAttributeTraversal("value", ic.modules.outputs[name].Traversal)
+			}
+			if err := writeHclFile(directory+"/outputs.tf", outputs); err != nil {
+				return err
+			}
+		}
+	}
+	log.Printf("[INFO] Writing root module with %d service modules", len(root.Body().Blocks()))
+	return writeHclFile(ic.Directory+"/main.tf", root)
+}
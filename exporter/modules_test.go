@@ -0,0 +1,46 @@
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitModulesWithIncremental(t *testing.T) {
+	ic := &importContext{
+		services:     map[string]struct{}{"compute": {}},
+		incremental:  true,
+		splitModules: true,
+	}
+	assert.EqualError(t, ic.Run(), "-splitModules can't be used together with -incremental")
+}
+
+func TestModulePaths(t *testing.T) {
+	ic := &importContext{Directory: "/tmp/export", Module: "module.workspace"}
+	assert.Equal(t, "/tmp/export", ic.moduleDirectory("compute"))
+	assert.Equal(t, "module.workspace.", ic.moduleAddressPrefix("compute"))
+	assert.Equal(t, "${path.module}/files/a.sh", ic.relativeFilePath("files/a.sh"))
+
+	ic.splitModules = true
+	assert.Equal(t, "/tmp/export/compute", ic.moduleDirectory("compute"))
+	assert.Equal(t, "module.workspace.module.compute.", ic.moduleAddressPrefix("compute"))
+	assert.Equal(t, "${path.module}/../files/a.sh", ic.relativeFilePath("files/a.sh"))
+}
+
+func TestAddModuleVariables(t *testing.T) {
+	ic := importContextForTest()
+	ic.splitModules = true
+	ic.modules = newSplitModulesState()
+	ic.addModuleVariables("jobs", []byte(`resource "databricks_job" "test" {
+  name        = "var.not_a_variable"
+  description = "${var.description} job"
+  task {
+    existing_cluster_id = var.databricks_cluster_test_id
+  }
+}
+`))
+	assert.Equal(t, map[string]struct{}{
+		"description":                {},
+		"databricks_cluster_test_id": {},
+	}, ic.modules.variables["jobs"])
+}
@@ -0,0 +1,175 @@
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	movedFileName   = "moved.tf"
+	removedFileName = "removed.tf"
+)
+
+func oldResourceKey(resourceType, id string) string {
+	return resourceType + "/" + id
+}
+
+// loadOldResourceNames builds mapping of resource type & ID to the resource name used in the previous run, using
+// import commands from the existing import.sh file
+func (ic *importContext) loadOldResourceNames() {
+	ic.oldResourceNames = map[string]string{}
+	modulePrefix := ""
+	if ic.Module != "" {
+		modulePrefix = ic.Module + "."
+	}
+	for command := range ic.shImports {
+		// terraform import <address> "<id>"
+		parts := strings.SplitN(command, " ", 4)
+		if len(parts) < 4 {
+			continue
+		}
+		address := strings.TrimPrefix(parts[2], modulePrefix)
+		resourceType, _, found := strings.Cut(address, ".")
+		if !found {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(parts[3], `"`), `"`)
+		ic.oldResourceNames[oldResourceKey(resourceType, id)] = address
+	}
+	log.Printf("[DEBUG] Loaded %d resource names from the previous run", len(ic.oldResourceNames))
+}
+
+// trackGeneratedResource records the generated resource, and detects if the same object was exported under
+// a different name in the previous run
+func (ic *importContext) trackGeneratedResource(r *resource, blockName string) {
+	if !ic.incremental || r.Mode == "data" {
+		return
+	}
+	oldName, moved := ic.workspaceMoves[blockName]
+	if !moved {
+		oldName, moved = ic.oldResourceNames[oldResourceKey(r.Resource, r.ID)]
+	}
+	ic.movedResourcesMutex.Lock()
+	defer ic.movedResourcesMutex.Unlock()
+	ic.generatedResources[blockName] = struct{}{}
+	if moved && oldName != blockName {
+		log.Printf("[DEBUG] resource %s was exported as %s in the previous run", blockName, oldName)
+		ic.movedResources[oldName] = blockName
+	}
+}
+
+// isRemovedResource returns true if the resource with a given name is deleted or moved to a new name, so it
+// shouldn't be preserved from the previous run
+func (ic *importContext) isRemovedResource(blockName string) bool {
+	if _, deleted := ic.deletedResources[blockName]; deleted {
+		return true
+	}
+	ic.movedResourcesMutex.RLock()
+	defer ic.movedResourcesMutex.RUnlock()
+	_, moved := ic.movedResources[blockName]
+	return moved
+}
+
+func addressTraversal(address string) hcl.Traversal {
+	parts := strings.Split(address, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return traversal
+}
+
+// readRefactoringBlocks reads existing `moved` or `removed` blocks, skipping those that refer to resources that
+// were generated in this run
+func (ic *importContext) readRefactoringBlocks(fileName string) []*hclwrite.Block {
+	content, err := os.ReadFile(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		log.Printf("[ERROR] error opening %s: %v", fileName, err)
+		return nil
+	}
+	f, diags := hclwrite.ParseConfig(content, fileName, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		log.Printf("[ERROR] parsing of existing file %s failed: %s", fileName, diags.Error())
+		return nil
+	}
+	blocks := []*hclwrite.Block{}
+	for _, block := range f.Body().Blocks() {
+		from := block.Body().GetAttribute("from")
+		if from == nil {
+			continue
+		}
+		address := strings.TrimSpace(string(from.Expr().BuildTokens(nil).Bytes()))
+		if _, generated := ic.generatedResources[address]; generated {
+			log.Printf("[DEBUG] resource %s is generated again, skipping existing %s block", address, block.Type())
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func (ic *importContext) writeRefactoringFile(fileName string, blocks []*hclwrite.Block) error {
+	if len(blocks) == 0 {
+		err := os.Remove(fileName)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	f := hclwrite.NewEmptyFile()
+	for _, block := range blocks {
+		f.Body().AppendBlock(block)
+	}
+	return os.WriteFile(fileName, hclwrite.Format(f.Bytes()), 0644)
+}
+
+// generateMovedAndRemovedBlocks writes `moved` blocks for objects that were exported under a different name in
+// the previous run, and `removed` blocks for deleted objects, so incremental exports could be applied without
+// recreation of resources. Blocks from previous runs are preserved.
+func (ic *importContext) generateMovedAndRemovedBlocks() error {
+	if !ic.incremental {
+		return nil
+	}
+	ic.movedResourcesMutex.RLock()
+	defer ic.movedResourcesMutex.RUnlock()
+
+	movedFile := fmt.Sprintf("%s/%s", ic.Directory, movedFileName)
+	movedBlocks := ic.readRefactoringBlocks(movedFile)
+	for _, from := range slices.Sorted(maps.Keys(ic.movedResources)) {
+		block := hclwrite.NewBlock("moved", nil)
+		block.Body().SetAttributeTraversal("from", addressTraversal(from))
+		block.Body().SetAttributeTraversal("to", addressTraversal(ic.movedResources[from]))
+		movedBlocks = append(movedBlocks, block)
+	}
+	if err := ic.writeRefactoringFile(movedFile, movedBlocks); err != nil {
+		return err
+	}
+
+	removedFile := fmt.Sprintf("%s/%s", ic.Directory, removedFileName)
+	removedBlocks := ic.readRefactoringBlocks(removedFile)
+	for _, from := range slices.Sorted(maps.Keys(ic.deletedResources)) {
+		if _, moved := ic.movedResources[from]; moved {
+			continue
+		}
+		if _, generated := ic.generatedResources[from]; generated {
+			continue
+		}
+		block := hclwrite.NewBlock("removed", nil)
+		block.Body().SetAttributeTraversal("from", addressTraversal(from))
+		block.Body().AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+		removedBlocks = append(removedBlocks, block)
+	}
+	log.Printf("[INFO] Writing %d moved and %d removed blocks", len(movedBlocks), len(removedBlocks))
+	return ic.writeRefactoringFile(removedFile, removedBlocks)
+}
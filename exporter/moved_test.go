@@ -0,0 +1,130 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/workspace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovedWsObjectsDetection(t *testing.T) {
+	ic := importContextForTest()
+	ic.incremental = true
+	ic.oldWorkspaceObjects = []workspace.ObjectStatus{
+		{ObjectID: 345, ObjectType: "NOTEBOOK", Path: "/Test/12345"},
+		{ObjectID: 789, ObjectType: "DIRECTORY", Path: "/Test/TDir"},
+	}
+	ic.allWorkspaceObjects = []workspace.ObjectStatus{
+		{ObjectID: 345, ObjectType: "NOTEBOOK", Path: "/Test/Moved"},
+	}
+	ic.findDeletedResources()
+	assert.Contains(t, ic.deletedResources, "databricks_notebook.test_12345_345")
+	assert.Contains(t, ic.deletedResources, "databricks_directory.test_tdir_789")
+	assert.Equal(t, map[string]string{
+		"databricks_notebook.test_moved_345":             "databricks_notebook.test_12345_345",
+		"databricks_permissions.notebook_test_moved_345": "databricks_permissions.notebook_test_12345_345",
+	}, ic.workspaceMoves)
+}
+
+func TestLoadOldResourceNames(t *testing.T) {
+	ic := importContextForTest()
+	ic.Module = "module.workspace"
+	ic.shImports = map[string]bool{
+		`terraform import module.workspace.databricks_job.old_name_14 "14"`:                        true,
+		`terraform import module.workspace.databricks_notebook.test_12345_345 "/Test/12345"`:       true,
+		`terraform import module.workspace.databricks_secret_acl.scope_user "scope|||user@domain"`: true,
+		`echo "not an import"`: true,
+	}
+	ic.loadOldResourceNames()
+	assert.Equal(t, map[string]string{
+		"databricks_job/14":                         "databricks_job.old_name_14",
+		"databricks_notebook//Test/12345":           "databricks_notebook.test_12345_345",
+		"databricks_secret_acl/scope|||user@domain": "databricks_secret_acl.scope_user",
+	}, ic.oldResourceNames)
+}
+
+func TestGenerateMovedAndRemovedBlocks(t *testing.T) {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(tmpDir, 0755))
+	defer os.RemoveAll(tmpDir)
+
+	ic := importContextForTest()
+	ic.Directory = tmpDir
+	ic.incremental = true
+	ic.oldResourceNames = map[string]string{
+		"databricks_job/14": "databricks_job.old_name_14",
+		"databricks_job/15": "databricks_job.same_15",
+	}
+	ic.workspaceMoves = map[string]string{
+		"databricks_notebook.test_moved_345": "databricks_notebook.test_12345_345",
+	}
+	ic.deletedResources = map[string]struct{}{
+		"databricks_notebook.test_12345_345":             {},
+		"databricks_permissions.notebook_test_12345_345": {},
+		"databricks_directory.test_tdir_789":             {},
+	}
+	require.NoError(t, os.WriteFile(tmpDir+"/"+movedFileName, []byte(`moved {
+  from = databricks_job.first_14
+  to   = databricks_job.old_name_14
+}
+moved {
+  from = databricks_job.same_15
+  to   = databricks_job.other_15
+}
+`), 0644))
+
+	ic.trackGeneratedResource(&resource{Resource: "databricks_job", ID: "14"}, "databricks_job.new_name_14")
+	ic.trackGeneratedResource(&resource{Resource: "databricks_job", ID: "15"}, "databricks_job.same_15")
+	ic.trackGeneratedResource(&resource{Resource: "databricks_notebook", ID: "/Test/Moved"},
+		"databricks_notebook.test_moved_345")
+	ic.trackGeneratedResource(&resource{Resource: "databricks_current_metastore", ID: "this", Mode: "data"},
+		"databricks_current_metastore.this")
+
+	assert.True(t, ic.isRemovedResource("databricks_job.old_name_14"))
+	assert.True(t, ic.isRemovedResource("databricks_directory.test_tdir_789"))
+	assert.False(t, ic.isRemovedResource("databricks_job.same_15"))
+
+	require.NoError(t, ic.generateMovedAndRemovedBlocks())
+	content, err := os.ReadFile(tmpDir + "/" + movedFileName)
+	require.NoError(t, err)
+	assert.Equal(t, `moved {
+  from = databricks_job.first_14
+  to   = databricks_job.old_name_14
+}
+moved {
+  from = databricks_job.old_name_14
+  to   = databricks_job.new_name_14
+}
+moved {
+  from = databricks_notebook.test_12345_345
+  to   = databricks_notebook.test_moved_345
+}
+`, string(content))
+
+	content, err = os.ReadFile(tmpDir + "/" + removedFileName)
+	require.NoError(t, err)
+	assert.Equal(t, `removed {
+  from = databricks_directory.test_tdir_789
+  lifecycle {
+    destroy = false
+  }
+}
+removed {
+  from = databricks_permissions.notebook_test_12345_345
+  lifecycle {
+    destroy = false
+  }
+}
+`, string(content))
+
+	// nothing is generated in non-incremental mode
+	ic.incremental = false
+	require.NoError(t, os.Remove(tmpDir+"/"+movedFileName))
+	require.NoError(t, ic.generateMovedAndRemovedBlocks())
+	_, err = os.Stat(tmpDir + "/" + movedFileName)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,212 @@
+package exporter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+const snapshotMetadataFileName = "snapshot.json"
+
+// snapshotMetadata describes the workspace or account from which API responses were recorded
+type snapshotMetadata struct {
+	Host      string `json:"host"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// snapshotEntry is a single recorded API call
+type snapshotEntry struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody string      `json:"request_body,omitempty"`
+	Status      int         `json:"status"`
+	Headers     http.Header `json:"headers,omitempty"`
+	Body        string      `json:"body"`
+}
+
+// snapshotFileName returns the name of the file with the recorded response for a given request. Requests are
+// identified by method, path with query, and body, so the host & authentication don't matter.
+func snapshotFileName(dir, method, url string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + url + "\n"))
+	h.Write(body)
+	return filepath.Join(dir, hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// recordingTransport saves all API responses into a directory, so they could be replayed by replayTransport
+type recordingTransport struct {
+	dir  string
+	cfg  *config.Config
+	next http.RoundTripper
+
+	metadataOnce sync.Once
+	metadataErr  error
+}
+
+func (t *recordingTransport) writeMetadata() error {
+	t.metadataOnce.Do(func() {
+		data, err := json.MarshalIndent(snapshotMetadata{Host: t.cfg.Host, AccountID: t.cfg.AccountID}, "", "  ")
+		if err == nil {
+			err = os.WriteFile(filepath.Join(t.dir, snapshotMetadataFileName), data, 0600)
+		}
+		t.metadataErr = err
+	})
+	return t.metadataErr
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// host is known only after the authentication, so metadata is written on the first request
+	if err := t.writeMetadata(); err != nil {
+		return nil, fmt.Errorf("can't write snapshot metadata: %w", err)
+	}
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	url := req.URL.RequestURI()
+	entry := snapshotEntry{
+		Method:      req.Method,
+		URL:         url,
+		RequestBody: string(reqBody),
+		Status:      resp.StatusCode,
+		Headers:     resp.Header.Clone(),
+		Body:        string(respBody),
+	}
+	entry.Headers.Del("Set-Cookie")
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	fileName := snapshotFileName(t.dir, req.Method, url, reqBody)
+	if err = os.WriteFile(fileName, data, 0600); err != nil {
+		return nil, fmt.Errorf("can't record response for %s %s: %w", req.Method, url, err)
+	}
+	log.Printf("[TRACE] Recorded %s %s into %s", req.Method, url, fileName)
+	return resp, nil
+}
+
+// replayTransport returns API responses recorded by recordingTransport without accessing the network
+type replayTransport struct {
+	dir string
+}
+
+// SkipRetryOnIO tells Go SDK to skip loading of the configuration & authentication, as nothing is sent over
+// the network
+func (t *replayTransport) SkipRetryOnIO() bool {
+	return true
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	url := req.URL.RequestURI()
+	data, err := os.ReadFile(snapshotFileName(t.dir, req.Method, url, reqBody))
+	if os.IsNotExist(err) {
+		log.Printf("[WARN] %s %s isn't found in the snapshot", req.Method, url)
+		body, _ := json.Marshal(map[string]string{
+			"error_code": "NOT_FOUND",
+			"message":    fmt.Sprintf("%s %s isn't found in the snapshot", req.Method, url),
+		})
+		return &http.Response{
+			Request:    req,
+			StatusCode: http.StatusNotFound,
+			Status:     http.StatusText(http.StatusNotFound),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var entry snapshotEntry
+	if err = json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("can't read recorded response for %s %s: %w", req.Method, url, err)
+	}
+	return &http.Response{
+		Request:    req,
+		StatusCode: entry.Status,
+		Status:     http.StatusText(entry.Status),
+		Header:     entry.Headers,
+		Body:       io.NopCloser(bytes.NewReader([]byte(entry.Body))),
+	}, nil
+}
+
+// setupSnapshot configures the client to record all API responses into recordDir, or to replay them from
+// replayDir instead of using the network
+func setupSnapshot(c *common.DatabricksClient, recordDir, replayDir string) error {
+	var cfg *config.Config
+	switch {
+	case recordDir != "" && replayDir != "":
+		return fmt.Errorf("-record and -replay can't be used together")
+	case recordDir != "":
+		if err := os.MkdirAll(recordDir, 0700); err != nil {
+			return fmt.Errorf("can't create directory %s: %w", recordDir, err)
+		}
+		cfg = c.DatabricksClient.Config
+		next := cfg.HTTPTransport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		cfg.HTTPTransport = &recordingTransport{dir: recordDir, cfg: cfg, next: next}
+		log.Printf("[INFO] Recording API responses into %s", recordDir)
+	case replayDir != "":
+		data, err := os.ReadFile(filepath.Join(replayDir, snapshotMetadataFileName))
+		if err != nil {
+			return fmt.Errorf("can't read snapshot from %s: %w", replayDir, err)
+		}
+		var metadata snapshotMetadata
+		if err = json.Unmarshal(data, &metadata); err != nil {
+			return fmt.Errorf("can't read snapshot from %s: %w", replayDir, err)
+		}
+		cfg = &config.Config{
+			Host:          metadata.Host,
+			AccountID:     metadata.AccountID,
+			HTTPTransport: &replayTransport{dir: replayDir},
+		}
+		log.Printf("[INFO] Replaying API responses of %s from %s", metadata.Host, replayDir)
+	default:
+		return nil
+	}
+	newClient, err := client.New(cfg)
+	if err != nil {
+		return err
+	}
+	c.DatabricksClient = newClient
+	return nil
+}
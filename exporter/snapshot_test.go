@@ -0,0 +1,77 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/client"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportClusters(t *testing.T, client *common.DatabricksClient) string {
+	tmpDir := fmt.Sprintf("/tmp/tf-%s", qa.RandomName())
+	defer os.RemoveAll(tmpDir)
+
+	ic := newImportContext(client)
+	ic.Directory = tmpDir
+	ic.noFormat = true
+	ic.enableListing("compute")
+	ic.enableServices("access,users,policies,compute,secrets,groups,storage")
+	require.NoError(t, ic.Run())
+	content, err := os.ReadFile(tmpDir + "/compute.tf")
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestRecordAndReplaySnapshot(t *testing.T) {
+	snapshotDir := fmt.Sprintf("/tmp/tf-snapshot-%s", qa.RandomName())
+	defer os.RemoveAll(snapshotDir)
+	os.Setenv("EXPORTER_PARALLELISM_default", "1")
+	defer os.Unsetenv("EXPORTER_PARALLELISM_default")
+
+	var host string
+	qa.HTTPFixturesApply(t, importingClustersFixtures(), func(ctx context.Context, client *common.DatabricksClient) {
+		host = client.Config.Host
+		require.NoError(t, setupSnapshot(client, snapshotDir, ""))
+		content := exportClusters(t, client)
+		assert.Contains(t, content, `resource "databricks_cluster" "test_cluster_policy_test2"`)
+	})
+	metadata, err := os.ReadFile(snapshotDir + "/" + snapshotMetadataFileName)
+	require.NoError(t, err)
+	assert.Contains(t, string(metadata), host)
+
+	// the fixtures server is stopped, so all responses must come from the snapshot
+	c := &common.DatabricksClient{}
+	require.NoError(t, setupSnapshot(c, "", snapshotDir))
+	assert.Equal(t, host, c.Config.Host)
+	content := exportClusters(t, c)
+	assert.Contains(t, content, `resource "databricks_cluster" "test_cluster_policy_test2"`)
+	assert.Regexp(t, `policy_id\s+= databricks_cluster_policy.users_cluster_policy.id`, content)
+}
+
+func TestReplayMissingResponse(t *testing.T) {
+	snapshotDir := fmt.Sprintf("/tmp/tf-snapshot-%s", qa.RandomName())
+	require.NoError(t, os.MkdirAll(snapshotDir, 0700))
+	defer os.RemoveAll(snapshotDir)
+	require.NoError(t, os.WriteFile(snapshotDir+"/"+snapshotMetadataFileName,
+		[]byte(`{"host": "https://example.cloud.databricks.com"}`), 0600))
+
+	c := &common.DatabricksClient{}
+	require.NoError(t, setupSnapshot(c, "", snapshotDir))
+	w, err := c.WorkspaceClient()
+	require.NoError(t, err)
+	_, err = w.Clusters.GetByClusterId(context.Background(), "abc")
+	assert.ErrorContains(t, err, "isn't found in the snapshot")
+}
+
+func TestSetupSnapshotErrors(t *testing.T) {
+	c := &common.DatabricksClient{DatabricksClient: &client.DatabricksClient{}}
+	assert.EqualError(t, setupSnapshot(c, "a", "b"), "-record and -replay can't be used together")
+	assert.ErrorContains(t, setupSnapshot(c, "", "/tmp/non-existent-snapshot"), "can't read snapshot")
+	require.NoError(t, setupSnapshot(c, "", ""))
+}
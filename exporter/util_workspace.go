@@ -128,7 +128,7 @@ func (ic *importContext) emitWorkspaceObject(objType, path string) {
 			ID:       strconv.FormatInt(repoId, 10),
 		})
 	} else {
-		ic.maybeEmitWorkspaceObject(objType, path, nil)
+		ic.maybeEmitWorkspaceObject(objType, path, nil, false)
 	}
 }
 
@@ -233,7 +233,8 @@ func (ic *importContext) shouldEmitForPath(path string) bool {
 	return true
 }
 
-func (ic *importContext) maybeEmitWorkspaceObject(resourceType, path string, obj *workspace.ObjectStatus) {
+// maybeEmitWorkspaceObject emits a workspace object, `listed` is set when it is emitted by the listing of workspace objects
+func (ic *importContext) maybeEmitWorkspaceObject(resourceType, path string, obj *workspace.ObjectStatus, listed bool) {
 	if ic.shouldEmitForPath(path) {
 		var data *schema.ResourceData
 		if obj != nil {
@@ -257,6 +258,7 @@ func (ic *importContext) maybeEmitWorkspaceObject(resourceType, path string, obj
 			ID:          path,
 			Data:        data,
 			Incremental: ic.incremental,
+			Listed:      listed,
 		})
 	} else {
 		log.Printf("[WARN] Not emitting a workspace object %s for deleted user. Path='%s'", resourceType, path)
@@ -303,11 +305,11 @@ func emitWorkpaceObject(ic *importContext, object workspace.ObjectStatus) {
 	}
 	switch object.ObjectType {
 	case workspace.Notebook:
-		ic.maybeEmitWorkspaceObject("databricks_notebook", object.Path, &object)
+		ic.maybeEmitWorkspaceObject("databricks_notebook", object.Path, &object, true)
 	case workspace.File:
-		ic.maybeEmitWorkspaceObject("databricks_workspace_file", object.Path, &object)
+		ic.maybeEmitWorkspaceObject("databricks_workspace_file", object.Path, &object, true)
 	case workspace.Directory:
-		ic.maybeEmitWorkspaceObject("databricks_directory", object.Path, &object)
+		ic.maybeEmitWorkspaceObject("databricks_directory", object.Path, &object, true)
 	default:
 		log.Printf("[WARN] unknown type %s for path %s", object.ObjectType, object.Path)
 	}
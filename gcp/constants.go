@@ -0,0 +1,9 @@
+package gcp
+
+import "regexp"
+
+var GcpProjectIdRegex = regexp.MustCompile(`^[a-z][-a-z0-9]{4,28}[a-z0-9]$`)
+var GcpProjectIdRegexError = "must contain between 6 and 30 lowercase letters, digits and hyphens, start with a letter and not end with a hyphen"
+
+var GcpBucketNameRegex = regexp.MustCompile(`^[a-z0-9][-_\.a-z0-9]{1,220}[a-z0-9]$`)
+var GcpBucketNameRegexError = "must contain only lowercase letters, digits, dot, underscore and hyphen characters"
@@ -0,0 +1,26 @@
+package gcp
+
+import (
+	"encoding/json"
+)
+
+// gcpCustomRole is the custom role in the format of `gcloud iam roles create --file`
+type gcpCustomRole struct {
+	Title               string   `json:"title"`
+	Description         string   `json:"description"`
+	Stage               string   `json:"stage"`
+	IncludedPermissions []string `json:"includedPermissions"`
+}
+
+func customRoleJSON(title, description string, permissions []string) (string, error) {
+	roleJSON, err := json.MarshalIndent(gcpCustomRole{
+		Title:               title,
+		Description:         description,
+		Stage:               "GA",
+		IncludedPermissions: permissions,
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(roleJSON), nil
+}
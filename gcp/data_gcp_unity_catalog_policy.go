@@ -0,0 +1,63 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataGcpUnityCatalogPolicy defines custom roles of the service account of the Unity Catalog storage credential
+func DataGcpUnityCatalogPolicy() common.Resource {
+	type GcpUcPolicy struct {
+		BucketName            string   `json:"bucket_name"`
+		Permissions           []string `json:"permissions" tf:"computed"`
+		JSON                  string   `json:"json" tf:"computed"`
+		FileEventsPermissions []string `json:"file_events_permissions" tf:"computed"`
+		FileEventsJSON        string   `json:"file_events_json" tf:"computed"`
+		Id                    string   `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *GcpUcPolicy) error {
+		if !GcpBucketNameRegex.MatchString(data.BucketName) {
+			return fmt.Errorf("bucket_name %s", GcpBucketNameRegexError)
+		}
+		// granted on the bucket
+		data.Permissions = []string{
+			"storage.buckets.get",
+			"storage.objects.create",
+			"storage.objects.delete",
+			"storage.objects.get",
+			"storage.objects.list",
+			// notifications of managed file events
+			"storage.buckets.update",
+		}
+		// granted on the project of the bucket for managed file events
+		data.FileEventsPermissions = []string{
+			"pubsub.subscriptions.consume",
+			"pubsub.subscriptions.create",
+			"pubsub.subscriptions.delete",
+			"pubsub.subscriptions.get",
+			"pubsub.subscriptions.list",
+			"pubsub.subscriptions.update",
+			"pubsub.topics.attachSubscription",
+			"pubsub.topics.create",
+			"pubsub.topics.delete",
+			"pubsub.topics.get",
+			"pubsub.topics.list",
+			"pubsub.topics.update",
+		}
+		var err error
+		data.JSON, err = customRoleJSON("Databricks Unity Catalog storage",
+			fmt.Sprintf("Unity Catalog access to the %s bucket", data.BucketName), data.Permissions)
+		if err != nil {
+			return err
+		}
+		data.FileEventsJSON, err = customRoleJSON("Databricks Unity Catalog file events",
+			fmt.Sprintf("Unity Catalog file events for the %s bucket", data.BucketName), data.FileEventsPermissions)
+		if err != nil {
+			return err
+		}
+		data.Id = data.BucketName
+		return nil
+	})
+}
@@ -0,0 +1,51 @@
+package gcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataGcpUnityCatalogPolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `bucket_name = "uc-bucket"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "uc-bucket", d.Id())
+	assert.Equal(t, 6, d.Get("permissions.#"))
+	assert.Equal(t, 12, d.Get("file_events_permissions.#"))
+	var role gcpCustomRole
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("json").(string)), &role))
+	assert.Equal(t, gcpCustomRole{
+		Title:       "Databricks Unity Catalog storage",
+		Description: "Unity Catalog access to the uc-bucket bucket",
+		Stage:       "GA",
+		IncludedPermissions: []string{
+			"storage.buckets.get",
+			"storage.objects.create",
+			"storage.objects.delete",
+			"storage.objects.get",
+			"storage.objects.list",
+			"storage.buckets.update",
+		},
+	}, role)
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("file_events_json").(string)), &role))
+	assert.Equal(t, "Databricks Unity Catalog file events", role.Title)
+	assert.Contains(t, role.IncludedPermissions, "pubsub.subscriptions.consume")
+}
+
+func TestDataGcpUnityCatalogPolicy_Invalid(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpUnityCatalogPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `bucket_name = "UC_Bucket"`,
+	}.ExpectError(t, "bucket_name "+GcpBucketNameRegexError)
+}
@@ -0,0 +1,72 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+)
+
+// DataGcpWorkspacePolicy defines custom roles of the principal, that creates workspaces with customer-managed VPC
+func DataGcpWorkspacePolicy() common.Resource {
+	type GcpWorkspacePolicy struct {
+		ProjectId          string   `json:"project_id"`
+		NetworkProjectId   string   `json:"network_project_id,omitempty" tf:"computed"`
+		Permissions        []string `json:"permissions" tf:"computed"`
+		JSON               string   `json:"json" tf:"computed"`
+		NetworkPermissions []string `json:"network_permissions" tf:"computed"`
+		NetworkJSON        string   `json:"network_json" tf:"computed"`
+		Id                 string   `json:"id" tf:"computed"`
+	}
+	return common.NoClientData(func(ctx context.Context, data *GcpWorkspacePolicy) error {
+		if !GcpProjectIdRegex.MatchString(data.ProjectId) {
+			return fmt.Errorf("project_id %s", GcpProjectIdRegexError)
+		}
+		if data.NetworkProjectId == "" {
+			data.NetworkProjectId = data.ProjectId
+		}
+		if !GcpProjectIdRegex.MatchString(data.NetworkProjectId) {
+			return fmt.Errorf("network_project_id %s", GcpProjectIdRegexError)
+		}
+		// granted on the project of the workspace
+		data.Permissions = []string{
+			"iam.roles.create",
+			"iam.roles.delete",
+			"iam.roles.get",
+			"iam.roles.update",
+			"iam.serviceAccounts.getIamPolicy",
+			"iam.serviceAccounts.setIamPolicy",
+			"resourcemanager.projects.get",
+			"resourcemanager.projects.getIamPolicy",
+			"resourcemanager.projects.setIamPolicy",
+			"serviceusage.services.enable",
+			"serviceusage.services.get",
+			"serviceusage.services.list",
+		}
+		// granted on the project of the VPC, which is the host project for Shared VPC
+		data.NetworkPermissions = []string{
+			"compute.firewalls.create",
+			"compute.firewalls.get",
+			"compute.networks.get",
+			"compute.networks.updatePolicy",
+			"compute.projects.get",
+			"compute.subnetworks.get",
+			"compute.subnetworks.getIamPolicy",
+			"compute.subnetworks.setIamPolicy",
+		}
+		var err error
+		data.JSON, err = customRoleJSON("Databricks workspace creator",
+			fmt.Sprintf("Creation of Databricks workspaces in the %s project", data.ProjectId), data.Permissions)
+		if err != nil {
+			return err
+		}
+		data.NetworkJSON, err = customRoleJSON("Databricks workspace network",
+			fmt.Sprintf("Customer-managed VPC of Databricks workspaces in the %s project", data.NetworkProjectId),
+			data.NetworkPermissions)
+		if err != nil {
+			return err
+		}
+		data.Id = fmt.Sprintf("%s-%s", data.ProjectId, data.NetworkProjectId)
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package gcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataGcpWorkspacePolicy(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspacePolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `project_id = "workspace-project"`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "workspace-project-workspace-project", d.Id())
+	assert.Equal(t, "workspace-project", d.Get("network_project_id"))
+	assert.Equal(t, 12, d.Get("permissions.#"))
+	assert.Equal(t, 8, d.Get("network_permissions.#"))
+	var role gcpCustomRole
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("network_json").(string)), &role))
+	assert.Equal(t, "Databricks workspace network", role.Title)
+	assert.Contains(t, role.IncludedPermissions, "compute.subnetworks.setIamPolicy")
+}
+
+func TestDataGcpWorkspacePolicy_SharedVpc(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspacePolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		project_id = "workspace-project"
+		network_project_id = "host-project"
+		`,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "workspace-project-host-project", d.Id())
+	var role gcpCustomRole
+	assert.NoError(t, json.Unmarshal([]byte(d.Get("network_json").(string)), &role))
+	assert.Equal(t, "Customer-managed VPC of Databricks workspaces in the host-project project", role.Description)
+}
+
+func TestDataGcpWorkspacePolicy_Invalid(t *testing.T) {
+	qa.ResourceFixture{
+		Read:        true,
+		Resource:    DataGcpWorkspacePolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `project_id = "1project"`,
+	}.ExpectError(t, "project_id "+GcpProjectIdRegexError)
+}
@@ -2,7 +2,10 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"os"
 
 	"github.com/databricks/databricks-sdk-go/client"
 	"github.com/databricks/databricks-sdk-go/config"
@@ -65,3 +68,51 @@ func PrepareDatabricksClient(ctx context.Context, cfg *config.Config, configCust
 	})
 	return pc, nil
 }
+
+// httpTransport returns the transport configured in the config or the new default transport
+func httpTransport(cfg *config.Config) http.RoundTripper {
+	if cfg.HTTPTransport != nil {
+		return cfg.HTTPTransport
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.InsecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	return transport
+}
+
+// ApplyAuditLog makes clients created from the config append every REST API request that could change
+// anything in Databricks to the audit log at the given path, or at the path from DATABRICKS_AUDIT_LOG_FILE
+// environment variable if it's empty. It must be applied before other transports, so every attempt is recorded.
+func ApplyAuditLog(cfg *config.Config, path string) error {
+	if path == "" {
+		path = os.Getenv(common.AuditLogFileEnv)
+	}
+	if path == "" {
+		return nil
+	}
+	transport, err := common.AuditLogTransport(path, httpTransport(cfg))
+	if err != nil {
+		return err
+	}
+	cfg.HTTPTransport = transport
+	return nil
+}
+
+// ApplyRetryPolicy makes every API request of clients created from the config retry transient errors
+// according to the given policy. The transport configured in the config, if any, is used for the requests.
+func ApplyRetryPolicy(cfg *config.Config, policy *common.RetryPolicy) {
+	cfg.HTTPTransport = policy.Transport(httpTransport(cfg))
+}
+
+// ApplyReadOnly makes clients created from the config reject all API requests that could change anything
+// in Databricks. It must be applied after other transports, so rejected requests are never retried.
+func ApplyReadOnly(cfg *config.Config) {
+	cfg.HTTPTransport = common.ReadOnlyTransport(httpTransport(cfg))
+}
+
+// ApplyReadCache makes clients created from the config cache responses of GET requests made with the context
+// from common.WithReadCache. It must be applied after other transports, so only successful responses are cached.
+func ApplyReadCache(cfg *config.Config) {
+	cfg.HTTPTransport = common.ReadCacheTransport(httpTransport(cfg))
+}
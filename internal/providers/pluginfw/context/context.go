@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/databricks/databricks-sdk-go/useragent"
+	tfcommon "github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/common"
 )
 
@@ -12,10 +13,18 @@ const sdkName = "pluginframework"
 
 func SetUserAgentInResourceContext(ctx context.Context, resourceName string) context.Context {
 	ctx = common.SetSDKInContext(ctx, sdkName)
+	// resource name is used to find the retry settings for the resource type
+	ctx = context.WithValue(ctx, tfcommon.ResourceName, resourceName)
 	return useragent.InContext(ctx, "resource", resourceName)
 }
 
 func SetUserAgentInDataSourceContext(ctx context.Context, dataSourceName string) context.Context {
 	ctx = common.SetSDKInContext(ctx, sdkName)
+	ctx = context.WithValue(ctx, tfcommon.ResourceName, dataSourceName)
 	return useragent.InContext(ctx, "data", dataSourceName)
 }
+
+func SetUserAgentInEphemeralResourceContext(ctx context.Context, ephemeralResourceName string) context.Context {
+	ctx = common.SetSDKInContext(ctx, sdkName)
+	return useragent.InContext(ctx, "ephemeral", ephemeralResourceName)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/databricks/databricks-sdk-go/useragent"
+	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,6 +15,7 @@ func TestSetUserAgentInResourceContext(t *testing.T) {
 	resourceName := "test-resource"
 	actualContext := SetUserAgentInResourceContext(ctx, resourceName)
 	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = context.WithValue(expectedContext, common.ResourceName, resourceName)
 	expectedContext = useragent.InContext(expectedContext, resourceKey, resourceName)
 	assert.Equal(t, expectedContext, actualContext)
 }
@@ -24,6 +26,17 @@ func TestSetUserAgentInDataSourceContext(t *testing.T) {
 	dataSourceName := "test-datasource"
 	actualContext := SetUserAgentInDataSourceContext(ctx, dataSourceName)
 	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = context.WithValue(expectedContext, common.ResourceName, dataSourceName)
 	expectedContext = useragent.InContext(expectedContext, dataSourceKey, dataSourceName)
 	assert.Equal(t, expectedContext, actualContext)
 }
+
+func TestSetUserAgentInEphemeralResourceContext(t *testing.T) {
+	ctx := context.Background()
+	ephemeralResourceKey := "ephemeral"
+	ephemeralResourceName := "test-ephemeral-resource"
+	actualContext := SetUserAgentInEphemeralResourceContext(ctx, ephemeralResourceName)
+	expectedContext := useragent.InContext(ctx, "sdk", "pluginframework")
+	expectedContext = useragent.InContext(expectedContext, ephemeralResourceKey, ephemeralResourceName)
+	assert.Equal(t, expectedContext, actualContext)
+}
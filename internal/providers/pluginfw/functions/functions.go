@@ -0,0 +1,18 @@
+// Package functions contains the provider-defined functions of the Databricks provider.
+//
+// The functions reuse the logic of the corresponding resources, so that names and paths built in HCL
+// are interpreted the same way as by the provider.
+package functions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Functions is the list of provider-defined functions.
+// Keep this list sorted.
+var Functions = []func() function.Function{
+	NewParseFullNameFunction,
+	NewPermissionsObjectIdFunction,
+	NewQuoteIdentifierFunction,
+	NewWorkspacePathJoinFunction,
+}
@@ -0,0 +1,108 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runFunction(t *testing.T, f function.Function, args []attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	definition := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	validation := function.DefinitionValidateResponse{}
+	definition.Definition.ValidateImplementation(ctx, function.DefinitionValidateRequest{}, &validation)
+	require.False(t, validation.Diagnostics.HasError(), validation.Diagnostics)
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(definition.Definition.Return.GetType().ValueType(ctx)),
+	}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestFunctionNames(t *testing.T) {
+	names := []string{}
+	for _, f := range Functions {
+		resp := function.MetadataResponse{}
+		f().Metadata(context.Background(), function.MetadataRequest{}, &resp)
+		names = append(names, resp.Name)
+	}
+	assert.Equal(t, []string{"parse_full_name", "permissions_object_id", "quote_identifier", "workspace_path_join"}, names)
+}
+
+func TestParseFullName(t *testing.T) {
+	result, err := runFunction(t, NewParseFullNameFunction(), []attr.Value{types.StringValue("main.`my.schema`.tbl")})
+	require.Nil(t, err)
+	assert.Equal(t, types.ObjectValueMust(map[string]attr.Type{
+		"catalog_name": types.StringType,
+		"schema_name":  types.StringType,
+		"name":         types.StringType,
+	}, map[string]attr.Value{
+		"catalog_name": types.StringValue("main"),
+		"schema_name":  types.StringValue("my.schema"),
+		"name":         types.StringValue("tbl"),
+	}), result)
+}
+
+func TestParseFullName_Partial(t *testing.T) {
+	result, err := runFunction(t, NewParseFullNameFunction(), []attr.Value{types.StringValue("main")})
+	require.Nil(t, err)
+	attrs := result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("main"), attrs["catalog_name"])
+	assert.Equal(t, types.StringValue(""), attrs["schema_name"])
+	assert.Equal(t, types.StringValue(""), attrs["name"])
+}
+
+func TestParseFullName_Errors(t *testing.T) {
+	for _, name := range []string{"a.b.c.d", "a..b", "`a"} {
+		_, err := runFunction(t, NewParseFullNameFunction(), []attr.Value{types.StringValue(name)})
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	result, err := runFunction(t, NewQuoteIdentifierFunction(), []attr.Value{types.StringValue("a`b")})
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("`a``b`"), result)
+}
+
+func TestWorkspacePathJoin(t *testing.T) {
+	for expected, elements := range map[string][]string{
+		"/Workspace":                          {},
+		"/Workspace/Users/me@example.com/dir": {"/Users", "me@example.com", "dir"},
+		"/Workspace/Users/me@example.com":     {"/Workspace/Users/", "me@example.com"},
+		"/Workspace/Shared/a/b":               {"Shared", "a//b/"},
+		"/Workspace/WorkspaceStuff":           {"/WorkspaceStuff"},
+	} {
+		assert.Equal(t, expected, joinWorkspacePath(elements...))
+	}
+}
+
+func TestWorkspacePathJoin_Run(t *testing.T) {
+	result, err := runFunction(t, NewWorkspacePathJoinFunction(), []attr.Value{
+		types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{
+			types.StringValue("/Users"), types.StringValue("me@example.com"),
+		}),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("/Workspace/Users/me@example.com"), result)
+}
+
+func TestPermissionsObjectId(t *testing.T) {
+	result, err := runFunction(t, NewPermissionsObjectIdFunction(), []attr.Value{types.StringValue("/jobs/123")})
+	require.Nil(t, err)
+	attrs := result.(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("job_id"), attrs["field"])
+	assert.Equal(t, types.StringValue("job"), attrs["object_type"])
+	assert.Equal(t, types.StringValue("jobs"), attrs["request_object_type"])
+	assert.Equal(t, types.StringValue("123"), attrs["object_id"])
+
+	_, err = runFunction(t, NewPermissionsObjectIdFunction(), []attr.Value{types.StringValue("/nope/123")})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,76 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseFullNameFunction{}
+
+func NewParseFullNameFunction() function.Function {
+	return &ParseFullNameFunction{}
+}
+
+// ParseFullNameFunction splits a Unity Catalog full name into catalog, schema and object names.
+type ParseFullNameFunction struct{}
+
+type fullName struct {
+	CatalogName string `tfsdk:"catalog_name"`
+	SchemaName  string `tfsdk:"schema_name"`
+	Name        string `tfsdk:"name"`
+}
+
+func (f *ParseFullNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_full_name"
+}
+
+func (f *ParseFullNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a Unity Catalog full name into its parts",
+		MarkdownDescription: "Splits a one-, two- or three-level Unity Catalog name, such as `main.default.table`, " +
+			"into `catalog_name`, `schema_name` and `name`. Parts may be quoted with backticks. " +
+			"Missing parts are returned as empty strings.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "full_name",
+				MarkdownDescription: "Unity Catalog full name to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"catalog_name": types.StringType,
+				"schema_name":  types.StringType,
+				"name":         types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseFullNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+	parts, err := catalog.ParseFullName(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if len(parts) > 3 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s has more than three parts", name))
+		return
+	}
+	var result fullName
+	for i, v := range []*string{&result.CatalogName, &result.SchemaName, &result.Name} {
+		if i < len(parts) {
+			*v = parts[i]
+		}
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
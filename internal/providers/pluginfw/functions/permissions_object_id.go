@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/permissions"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &PermissionsObjectIdFunction{}
+
+func NewPermissionsObjectIdFunction() function.Function {
+	return &PermissionsObjectIdFunction{}
+}
+
+// PermissionsObjectIdFunction parses the `/<type>/<id>` ID of databricks_permissions.
+type PermissionsObjectIdFunction struct{}
+
+type permissionsObject struct {
+	Field             string `tfsdk:"field"`
+	ObjectType        string `tfsdk:"object_type"`
+	RequestObjectType string `tfsdk:"request_object_type"`
+	ObjectId          string `tfsdk:"object_id"`
+}
+
+func (f *PermissionsObjectIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "permissions_object_id"
+}
+
+func (f *PermissionsObjectIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the ID of a databricks_permissions resource",
+		MarkdownDescription: "Parses an ID such as `/clusters/0123-456789-abcdef` into the `field` of `databricks_permissions` " +
+			"used to configure the object (`cluster_id`), the Permissions API `object_type` (`cluster`), " +
+			"the `request_object_type` (`clusters`) and the `object_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of databricks_permissions resource",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"field":               types.StringType,
+				"object_type":         types.StringType,
+				"request_object_type": types.StringType,
+				"object_id":           types.StringType,
+			},
+		},
+	}
+}
+
+func (f *PermissionsObjectIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	object, err := permissions.ParsePermissionsObjectId(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, permissionsObject{
+		Field:             object.Field,
+		ObjectType:        object.ObjectType,
+		RequestObjectType: object.RequestObjectType,
+		ObjectId:          object.ObjectId,
+	})
+}
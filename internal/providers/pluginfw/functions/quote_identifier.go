@@ -0,0 +1,45 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &QuoteIdentifierFunction{}
+
+func NewQuoteIdentifierFunction() function.Function {
+	return &QuoteIdentifierFunction{}
+}
+
+// QuoteIdentifierFunction quotes a Unity Catalog identifier for use in SQL statements.
+type QuoteIdentifierFunction struct{}
+
+func (f *QuoteIdentifierFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "quote_identifier"
+}
+
+func (f *QuoteIdentifierFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Quotes a Unity Catalog identifier for use in SQL",
+		MarkdownDescription: "Wraps a single identifier in backticks, escaping backticks inside of it, " +
+			"the same way as `databricks_sql_table` does in the generated SQL statements.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "identifier",
+				MarkdownDescription: "Identifier to quote",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *QuoteIdentifierFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var identifier string
+	resp.Error = req.Arguments.Get(ctx, &identifier)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, catalog.QuoteIdentifier(identifier))
+}
@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &WorkspacePathJoinFunction{}
+
+func NewWorkspacePathJoinFunction() function.Function {
+	return &WorkspacePathJoinFunction{}
+}
+
+// WorkspacePathJoinFunction builds an absolute workspace path from its elements.
+type WorkspacePathJoinFunction struct{}
+
+func (f *WorkspacePathJoinFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "workspace_path_join"
+}
+
+func (f *WorkspacePathJoinFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Joins elements into an absolute workspace path",
+		MarkdownDescription: "Joins path elements with `/`, cleans the result and makes sure it starts with `/Workspace`. " +
+			"Elements may or may not include the `/Workspace` prefix, e.g. `workspace_path_join(\"/Users\", \"me@example.com\", \"dir\")` " +
+			"returns `/Workspace/Users/me@example.com/dir`.",
+		VariadicParameter: function.StringParameter{
+			Name:                "elements",
+			MarkdownDescription: "Path elements to join",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// joinWorkspacePath joins elements into an absolute path that starts with the `/Workspace` prefix.
+func joinWorkspacePath(elements ...string) string {
+	p := path.Join(append([]string{"/"}, elements...)...)
+	if p == common.WorkspacePathPrefix || strings.HasPrefix(p, common.WorkspacePathPrefix+"/") {
+		return p
+	}
+	return path.Join(common.WorkspacePathPrefix, p)
+}
+
+func (f *WorkspacePathJoinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var elements []string
+	resp.Error = req.Arguments.Get(ctx, &elements)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, joinWorkspacePath(elements...))
+}
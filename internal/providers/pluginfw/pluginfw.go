@@ -14,8 +14,12 @@ import (
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/client"
 	providercommon "github.com/databricks/terraform-provider-databricks/internal/providers/common"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/functions"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -45,15 +49,30 @@ type DatabricksProviderPluginFramework struct {
 }
 
 var _ provider.Provider = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithEphemeralResources = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithFunctions = (*DatabricksProviderPluginFramework)(nil)
+var _ provider.ProviderWithListResources = (*DatabricksProviderPluginFramework)(nil)
 
 func (p *DatabricksProviderPluginFramework) Resources(ctx context.Context) []func() resource.Resource {
-	return getPluginFrameworkResourcesToRegister(p.sdkV2ResourceFallbacks)
+	return wrapResources(getPluginFrameworkResourcesToRegister(p.sdkV2ResourceFallbacks))
 }
 
 func (p *DatabricksProviderPluginFramework) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return getPluginFrameworkDataSourcesToRegister(p.sdkV2DataSourceFallbacks)
 }
 
+func (p *DatabricksProviderPluginFramework) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return pluginFwOnlyEphemeralResources
+}
+
+func (p *DatabricksProviderPluginFramework) ListResources(ctx context.Context) []func() list.ListResource {
+	return pluginFwOnlyListResources
+}
+
+func (p *DatabricksProviderPluginFramework) Functions(ctx context.Context) []func() function.Function {
+	return functions.Functions
+}
+
 func (p *DatabricksProviderPluginFramework) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = providerSchemaPluginFramework()
 }
@@ -67,6 +86,8 @@ func (p *DatabricksProviderPluginFramework) Configure(ctx context.Context, req p
 	client := p.configureDatabricksClient(ctx, req, resp)
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 }
 
 // Function returns a schema.Schema based on config attributes where each attribute is mapped to the appropriate
@@ -98,8 +119,20 @@ func providerSchemaPluginFramework() schema.Schema {
 			}
 		}
 	}
+	ps["audit_log_file"] = schema.StringAttribute{
+		Optional: true,
+	}
+	ps["read_only"] = schema.BoolAttribute{
+		Optional: true,
+	}
+	ps["read_cache"] = schema.BoolAttribute{
+		Optional: true,
+	}
 	return schema.Schema{
 		Attributes: ps,
+		Blocks: map[string]schema.Block{
+			"retry": retryBlock(),
+		},
 	}
 }
 
@@ -199,10 +232,44 @@ func (p *DatabricksProviderPluginFramework) configureDatabricksClient(ctx contex
 	} else {
 		tflog.Info(ctx, "(plugin framework) No attributes specified in provider configuration")
 	}
+	var auditLogFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("audit_log_file"), &auditLogFile)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	if err := client.ApplyAuditLog(cfg, auditLogFile.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to configure audit log", err.Error())
+		return nil
+	}
+	retryPolicy, diags := retryPolicyFromConfig(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return nil
+	}
+	if retryPolicy != nil {
+		client.ApplyRetryPolicy(cfg, retryPolicy)
+	}
+	var readOnly types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("read_only"), &readOnly)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	if readOnly.ValueBool() {
+		client.ApplyReadOnly(cfg)
+	}
+	var readCache types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("read_cache"), &readCache)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+	if readCache.ValueBool() {
+		client.ApplyReadCache(cfg)
+	}
 	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, p.configCustomizer)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to configure Databricks client", err.Error())
 		return nil
 	}
+	databricksClient.SetReadOnly(readOnly.ValueBool())
 	return databricksClient
 }
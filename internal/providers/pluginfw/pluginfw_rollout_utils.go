@@ -18,14 +18,18 @@ import (
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/cluster"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/dashboards"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/library"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/listresources"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/notificationdestinations"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/qualitymonitor"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/registered_model"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/serving"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/sharing"
+	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/tokens"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/user"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/products/volume"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -73,6 +77,29 @@ var pluginFwOnlyDataSources = append(
 	autoGeneratedDataSources...,
 )
 
+// List of ephemeral resources. These only exist in the plugin framework as SDK V2 has no support for them.
+// Keep this list sorted.
+var pluginFwOnlyEphemeralResources = []func() ephemeral.EphemeralResource{
+	tokens.EphemeralResourceOboToken,
+	tokens.EphemeralResourceServicePrincipalSecret,
+	tokens.EphemeralResourceToken,
+}
+
+// List of list resources, used by `terraform query`. The listed resources are implemented with SDK V2, which has
+// no support for list resources, so these only exist in the plugin framework.
+// Keep this list sorted.
+var pluginFwOnlyListResources = []func() list.ListResource{
+	listresources.ListResourceCatalog,
+	listresources.ListResourceCluster,
+	listresources.ListResourceGroup,
+	listresources.ListResourceJob,
+	listresources.ListResourcePipeline,
+	listresources.ListResourceSchema,
+	listresources.ListResourceServicePrincipal,
+	listresources.ListResourceSqlEndpoint,
+	listresources.ListResourceSqlTable,
+}
+
 type pluginFrameworkOptions struct {
 	resourceFallbacks   []string
 	dataSourceFallbacks []string
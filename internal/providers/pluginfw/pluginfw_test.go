@@ -2,10 +2,13 @@ package pluginfw
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +65,34 @@ func TestConfigure(t *testing.T) {
 				assert.Equal(t, "9876543210", dc.Config.WorkspaceID, "workspace_id should be set")
 			},
 		},
+		{
+			name: "read_only can be set to true",
+			config: map[string]tftypes.Value{
+				"read_only": tftypes.NewValue(tftypes.Bool, true),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.True(t, dc.IsReadOnly(), "client should be read-only when read_only is set")
+				assert.NotNil(t, dc.Config.HTTPTransport, "non-GET requests should be rejected")
+			},
+		},
+		{
+			name: "audit_log_file can be set",
+			config: map[string]tftypes.Value{
+				"audit_log_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "audit.jsonl")),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.NotNil(t, dc.Config.HTTPTransport, "mutating requests should be recorded")
+			},
+		},
+		{
+			name: "read_cache can be set to true",
+			config: map[string]tftypes.Value{
+				"read_cache": tftypes.NewValue(tftypes.Bool, true),
+			},
+			validateResourceData: func(dc *common.DatabricksClient) {
+				assert.NotNil(t, dc.Config.HTTPTransport, "GET requests should be cached")
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -96,3 +127,27 @@ func TestConfigure(t *testing.T) {
 		})
 	}
 }
+
+func TestEphemeralResources(t *testing.T) {
+	p := GetDatabricksProviderPluginFramework().(provider.ProviderWithEphemeralResources)
+	names := []string{}
+	for _, ephemeralResourceFunc := range p.EphemeralResources(context.Background()) {
+		resp := ephemeral.MetadataResponse{}
+		ephemeralResourceFunc().Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "databricks"}, &resp)
+		names = append(names, resp.TypeName)
+	}
+	assert.Equal(t, []string{"databricks_obo_token", "databricks_service_principal_secret", "databricks_token"}, names)
+}
+
+func TestListResources(t *testing.T) {
+	p := GetDatabricksProviderPluginFramework().(provider.ProviderWithListResources)
+	names := []string{}
+	for _, listResourceFunc := range p.ListResources(context.Background()) {
+		resp := resource.MetadataResponse{}
+		listResourceFunc().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "databricks"}, &resp)
+		names = append(names, resp.TypeName)
+	}
+	assert.Equal(t, []string{"databricks_catalog", "databricks_cluster", "databricks_group", "databricks_job",
+		"databricks_pipeline", "databricks_schema", "databricks_service_principal", "databricks_sql_endpoint",
+		"databricks_sql_table"}, names)
+}
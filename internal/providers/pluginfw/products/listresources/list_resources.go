@@ -0,0 +1,201 @@
+package listresources
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/config"
+	sdkcatalog "github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/iam"
+	sdkjobs "github.com/databricks/databricks-sdk-go/service/jobs"
+	sdkpipelines "github.com/databricks/databricks-sdk-go/service/pipelines"
+	sdksql "github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/databricks/terraform-provider-databricks/catalog"
+	"github.com/databricks/terraform-provider-databricks/clusters"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/jobs"
+	"github.com/databricks/terraform-provider-databricks/pipelines"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/databricks/terraform-provider-databricks/sql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ListResourceCluster() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "cluster",
+		resource: clusters.ResourceCluster,
+		list: workspaceLister(clusters.ListInteractiveClusters, func(c compute.ClusterDetails) listedObject {
+			return listedObject{ID: c.ClusterId, DisplayName: c.ClusterName}
+		}),
+	}
+}
+
+func ListResourceJob() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "job",
+		resource: jobs.ResourceJob,
+		list: workspaceLister(jobs.ListJobs, func(job sdkjobs.BaseJob) listedObject {
+			object := listedObject{ID: strconv.FormatInt(job.JobId, 10)}
+			if job.Settings != nil {
+				object.DisplayName = job.Settings.Name
+			}
+			return object
+		}),
+	}
+}
+
+func ListResourcePipeline() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "pipeline",
+		resource: pipelines.ResourcePipeline,
+		list: workspaceLister(pipelines.ListPipelines, func(p sdkpipelines.PipelineStateInfo) listedObject {
+			return listedObject{ID: p.PipelineId, DisplayName: p.Name}
+		}),
+	}
+}
+
+func ListResourceSqlEndpoint() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "sql_endpoint",
+		resource: sql.ResourceSqlEndpoint,
+		list: workspaceLister(sql.ListWarehouses, func(e sdksql.EndpointInfo) listedObject {
+			return listedObject{ID: e.Id, DisplayName: e.Name}
+		}),
+	}
+}
+
+func ListResourceCatalog() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "catalog",
+		resource: catalog.ResourceCatalog,
+		list: workspaceLister(catalog.ListCatalogs, func(ci sdkcatalog.CatalogInfo) listedObject {
+			return listedObject{ID: ci.Name, DisplayName: ci.Name}
+		}),
+	}
+}
+
+type schemaListConfig struct {
+	CatalogName types.String `tfsdk:"catalog_name"`
+}
+
+func ListResourceSchema() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "schema",
+		resource: catalog.ResourceSchema,
+		attributes: map[string]schema.Attribute{
+			"catalog_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the catalog to list schemas from.",
+			},
+		},
+		list: func(ctx context.Context, c *common.DatabricksClient, config tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics) {
+			var cfg schemaListConfig
+			diags := config.Get(ctx, &cfg)
+			if diags.HasError() {
+				return nil, diags
+			}
+			return workspaceLister(func(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[sdkcatalog.SchemaInfo, error] {
+				return catalog.ListSchemas(ctx, w, cfg.CatalogName.ValueString())
+			}, func(s sdkcatalog.SchemaInfo) listedObject {
+				return listedObject{ID: s.FullName, DisplayName: s.FullName}
+			})(ctx, c, config)
+		},
+	}
+}
+
+type sqlTableListConfig struct {
+	CatalogName types.String `tfsdk:"catalog_name"`
+	SchemaName  types.String `tfsdk:"schema_name"`
+}
+
+func ListResourceSqlTable() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "sql_table",
+		resource: catalog.ResourceSqlTable,
+		attributes: map[string]schema.Attribute{
+			"catalog_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the catalog to list tables from.",
+			},
+			"schema_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the schema to list tables from.",
+			},
+		},
+		list: func(ctx context.Context, c *common.DatabricksClient, config tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics) {
+			var cfg sqlTableListConfig
+			diags := config.Get(ctx, &cfg)
+			if diags.HasError() {
+				return nil, diags
+			}
+			return workspaceLister(func(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[sdkcatalog.TableInfo, error] {
+				return catalog.ListSqlTables(ctx, w, cfg.CatalogName.ValueString(), cfg.SchemaName.ValueString())
+			}, func(t sdkcatalog.TableInfo) listedObject {
+				return listedObject{ID: t.FullName, DisplayName: t.FullName}
+			})(ctx, c, config)
+		},
+	}
+}
+
+func ListResourceGroup() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "group",
+		resource: scim.ResourceGroup,
+		list: func(ctx context.Context, c *common.DatabricksClient, cfg tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics) {
+			toObject := func(g iam.Group) listedObject {
+				return listedObject{ID: g.Id, DisplayName: g.DisplayName}
+			}
+			if c.Config.HostType() == config.AccountHost {
+				a, diags := c.GetAccountClient()
+				if diags.HasError() {
+					return nil, diags
+				}
+				it := a.Groups.List(ctx, iam.ListAccountGroupsRequest{Attributes: "id,displayName"})
+				return toListedObjects(common.ListingSeq(ctx, it, nil), toObject), diags
+			}
+			return workspaceLister(func(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[iam.Group, error] {
+				it := w.Groups.List(ctx, iam.ListGroupsRequest{Attributes: "id,displayName"})
+				return common.ListingSeq(ctx, it, nil)
+			}, toObject)(ctx, c, cfg)
+		},
+	}
+}
+
+func ListResourceServicePrincipal() list.ListResource {
+	return &sdkV2ListResource{
+		name:     "service_principal",
+		resource: scim.ResourceServicePrincipal,
+		list: func(ctx context.Context, c *common.DatabricksClient, cfg tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics) {
+			toObject := func(sp iam.ServicePrincipal) listedObject {
+				displayName := sp.DisplayName
+				if displayName == "" {
+					displayName = sp.ApplicationId
+				}
+				return listedObject{ID: sp.Id, DisplayName: displayName}
+			}
+			if c.Config.HostType() == config.AccountHost {
+				a, diags := c.GetAccountClient()
+				if diags.HasError() {
+					return nil, diags
+				}
+				it := a.ServicePrincipals.List(ctx, iam.ListAccountServicePrincipalsRequest{
+					Attributes: "id,displayName,applicationId",
+				})
+				return toListedObjects(common.ListingSeq(ctx, it, nil), toObject), diags
+			}
+			return workspaceLister(func(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[iam.ServicePrincipal, error] {
+				it := w.ServicePrincipals.List(ctx, iam.ListServicePrincipalsRequest{
+					Attributes: "id,displayName,applicationId",
+				})
+				return common.ListingSeq(ctx, it, nil)
+			}, toObject)(ctx, c, cfg)
+		},
+	}
+}
@@ -0,0 +1,244 @@
+// Package listresources contains list resources, used by `terraform query` to discover existing objects
+// that can then be imported into the Terraform state.
+//
+// The managed resources being listed are implemented with SDKv2, so the list resources use the same listing
+// functions as the exporter, and convert the SDKv2 schemas of the managed resources into the ProtoV6 schemas
+// that the Plugin Framework needs to serve them.
+package listresources
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listedObject is an object found by a list resource.
+type listedObject struct {
+	// ID is the ID of the managed resource, which is also its identity.
+	ID string
+	// DisplayName is a human-readable name of the object shown by `terraform query`.
+	DisplayName string
+}
+
+// lister returns the objects matching the configuration of the list block.
+type lister func(ctx context.Context, c *common.DatabricksClient, config tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics)
+
+var _ list.ListResourceWithConfigure = &sdkV2ListResource{}
+var _ list.ListResourceWithRawV6Schemas = &sdkV2ListResource{}
+
+// sdkV2ListResource lists instances of a managed resource implemented with SDKv2, that has WithIdentity set.
+type sdkV2ListResource struct {
+	// name is the name of the managed resource without the `databricks_` prefix.
+	name string
+	// resource returns the definition of the managed resource.
+	resource func() common.Resource
+	// attributes are the attributes of the list block, if any.
+	attributes map[string]schema.Attribute
+	list       lister
+
+	Client *common.DatabricksClient
+}
+
+func (r *sdkV2ListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(r.name)
+}
+
+func (r *sdkV2ListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	attributes := r.attributes
+	if attributes == nil {
+		attributes = map[string]schema.Attribute{}
+	}
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists %s resources that can be imported", pluginfwcommon.GetDatabricksProductionName(r.name)),
+		Attributes:  attributes,
+	}
+}
+
+func (r *sdkV2ListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureResource(req, resp)
+	}
+}
+
+// server returns a ProtoV6 server serving only the managed resource, which is used to get its schemas and
+// to read its state.
+func (r *sdkV2ListResource) server(ctx context.Context) (tfprotov6.ProviderServer, error) {
+	typeName := pluginfwcommon.GetDatabricksProductionName(r.name)
+	p := &sdkv2schema.Provider{
+		ResourcesMap: map[string]*sdkv2schema.Resource{
+			typeName: r.resource().ToResource(),
+		},
+	}
+	common.AddContextToAllResources(p, "databricks")
+	if r.Client != nil {
+		p.SetMeta(r.Client)
+	}
+	return tf5to6server.UpgradeServer(ctx, func() tfprotov5.ProviderServer {
+		return sdkv2schema.NewGRPCProviderServer(p)
+	})
+}
+
+func (r *sdkV2ListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	typeName := pluginfwcommon.GetDatabricksProductionName(r.name)
+	server, err := r.server(ctx)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("failed to convert the schema of %s: %s", typeName, err))
+		return
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("failed to convert the schema of %s: %s", typeName, err))
+		return
+	}
+	identityResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("failed to convert the identity schema of %s: %s", typeName, err))
+		return
+	}
+	resp.ProtoV6Schema = schemaResp.ResourceSchemas[typeName]
+	resp.ProtoV6IdentitySchema = identityResp.IdentitySchemas[typeName]
+}
+
+func (r *sdkV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ctx = pluginfwcontext.SetUserAgentInResourceContext(ctx, r.name)
+	objects, diags := r.list(ctx, r.Client, req.Config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	var server tfprotov6.ProviderServer
+	var resourceType tftypes.Object
+	if req.IncludeResource {
+		var err error
+		server, err = r.server(ctx)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to list %s", r.name), err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		var ok bool
+		resourceType, ok = req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+		if !ok {
+			diags.AddError(fmt.Sprintf("failed to list %s", r.name), "resource schema is not an object")
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for object, err := range objects {
+			if err != nil {
+				diags.AddError(fmt.Sprintf("failed to list %s", r.name), err.Error())
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = object.DisplayName
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(common.IdentityAttribute), object.ID)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				state, readDiags := r.read(ctx, server, resourceType, object.ID)
+				result.Diagnostics.Append(readDiags...)
+				if state == nil && !result.Diagnostics.HasError() {
+					// the object was removed since it was listed
+					continue
+				}
+				if state != nil {
+					result.Resource.Raw = *state
+				}
+			}
+			count++
+			if !push(result) || (req.Limit > 0 && count >= req.Limit) {
+				return
+			}
+		}
+	}
+}
+
+// read reads the state of the managed resource with the given ID using its SDKv2 implementation, the
+// same way as it's done during import. It returns nil if the resource doesn't exist anymore.
+func (r *sdkV2ListResource) read(ctx context.Context, server tfprotov6.ProviderServer, resourceType tftypes.Object,
+	id string) (*tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	summary := fmt.Sprintf("failed to read %s %s", r.name, id)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range resourceType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["id"] = tftypes.NewValue(tftypes.String, id)
+	currentState, err := tfprotov6.NewDynamicValue(resourceType, tftypes.NewValue(resourceType, attributes))
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return nil, diags
+	}
+	resp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     pluginfwcommon.GetDatabricksProductionName(r.name),
+		CurrentState: &currentState,
+	})
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return nil, diags
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	if diags.HasError() || resp.NewState == nil {
+		return nil, diags
+	}
+	newState, err := resp.NewState.Unmarshal(resourceType)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return nil, diags
+	}
+	if newState.IsNull() {
+		return nil, diags
+	}
+	return &newState, diags
+}
+
+// workspaceLister returns a lister for objects of the workspace configured in the provider.
+func workspaceLister[T any](listFunc func(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[T, error],
+	toObject func(T) listedObject) lister {
+	return func(ctx context.Context, c *common.DatabricksClient, config tfsdk.Config) (iter.Seq2[listedObject, error], diag.Diagnostics) {
+		w, diags := c.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+		if diags.HasError() {
+			return nil, diags
+		}
+		return toListedObjects(listFunc(ctx, w), toObject), diags
+	}
+}
+
+// toListedObjects converts a sequence of API objects into a sequence of listed objects.
+func toListedObjects[T any](items iter.Seq2[T, error], toObject func(T) listedObject) iter.Seq2[listedObject, error] {
+	return func(yield func(listedObject, error) bool) {
+		for item, err := range items {
+			if err != nil {
+				yield(listedObject{}, err)
+				return
+			}
+			if !yield(toObject(item), nil) {
+				return
+			}
+		}
+	}
+}
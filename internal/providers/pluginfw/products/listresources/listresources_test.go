@@ -0,0 +1,188 @@
+package listresources
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	sdkcatalog "github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/databricks/terraform-provider-databricks/scim"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newListRequest(ctx context.Context, t *testing.T, r list.ListResource, config map[string]string, limit int64) list.ListRequest {
+	schemaResp := list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	configSchema := schemaResp.Schema
+	values := map[string]tftypes.Value{}
+	for name := range configSchema.Attributes {
+		values[name] = tftypes.NewValue(tftypes.String, config[name])
+	}
+	require.Len(t, values, len(config))
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema,
+			Raw:    tftypes.NewValue(configSchema.Type().TerraformType(ctx), values),
+		},
+		Limit: limit,
+		ResourceSchema: resourceschema.Schema{
+			Attributes: map[string]resourceschema.Attribute{
+				"id": resourceschema.StringAttribute{Computed: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+type listedResult struct {
+	ID          string
+	DisplayName string
+}
+
+func collectResults(ctx context.Context, t *testing.T, stream list.ListResultsStream) []listedResult {
+	results := []listedResult{}
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+		var id types.String
+		diags := result.Identity.GetAttribute(ctx, path.Root("id"), &id)
+		require.False(t, diags.HasError(), "%v", diags)
+		results = append(results, listedResult{ID: id.ValueString(), DisplayName: result.DisplayName})
+	}
+	return results
+}
+
+func TestRawV6Schemas(t *testing.T) {
+	ctx := context.Background()
+	for _, f := range []func() list.ListResource{ListResourceCluster, ListResourceSqlTable, ListResourceGroup} {
+		r := f().(list.ListResourceWithRawV6Schemas)
+		resp := list.RawV6SchemaResponse{}
+		r.RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &resp)
+		require.NotNil(t, resp.ProtoV6Schema)
+		require.NotNil(t, resp.ProtoV6IdentitySchema)
+		require.Len(t, resp.ProtoV6IdentitySchema.IdentityAttributes, 1)
+		assert.Equal(t, common.IdentityAttribute, resp.ProtoV6IdentitySchema.IdentityAttributes[0].Name)
+		assert.True(t, resp.ProtoV6IdentitySchema.IdentityAttributes[0].RequiredForImport)
+	}
+}
+
+func TestListClusters(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockClustersAPI().EXPECT().List(mock.Anything, compute.ListClustersRequest{
+			FilterBy: &compute.ListClustersFilterBy{
+				ClusterSources: []compute.ClusterSource{compute.ClusterSourceUi, compute.ClusterSourceApi},
+			},
+			PageSize: 100,
+		}).Return(&listing.SliceIterator[compute.ClusterDetails]{
+			{ClusterId: "abc", ClusterName: "Shared"},
+			{ClusterId: "def", ClusterName: "Personal"},
+			{ClusterId: "ghi", ClusterName: "Other"},
+		})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ListResourceCluster().(*sdkV2ListResource)
+		r.Client = client
+		stream := list.ListResultsStream{}
+		r.List(ctx, newListRequest(ctx, t, r, nil, 2), &stream)
+		assert.Equal(t, []listedResult{
+			{ID: "abc", DisplayName: "Shared"},
+			{ID: "def", DisplayName: "Personal"},
+		}, collectResults(ctx, t, stream))
+	})
+}
+
+func TestListSchemas(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockSchemasAPI().EXPECT().List(mock.Anything, sdkcatalog.ListSchemasRequest{
+			CatalogName: "main",
+		}).Return(&listing.SliceIterator[sdkcatalog.SchemaInfo]{
+			{FullName: "main.default", Name: "default", CatalogType: sdkcatalog.CatalogTypeManagedCatalog},
+			{FullName: "main.information_schema", Name: "information_schema", CatalogType: sdkcatalog.CatalogTypeManagedCatalog},
+		})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ListResourceSchema().(*sdkV2ListResource)
+		r.Client = client
+		stream := list.ListResultsStream{}
+		r.List(ctx, newListRequest(ctx, t, r, map[string]string{"catalog_name": "main"}, 0), &stream)
+		assert.Equal(t, []listedResult{
+			{ID: "main.default", DisplayName: "main.default"},
+		}, collectResults(ctx, t, stream))
+	})
+}
+
+func TestListResourceConfigSchema(t *testing.T) {
+	resp := list.ListResourceSchemaResponse{}
+	ListResourceSqlTable().ListResourceConfigSchema(context.Background(), list.ListResourceSchemaRequest{}, &resp)
+	assert.Equal(t, []string{"catalog_name", "schema_name"}, slices.Sorted(maps.Keys(resp.Schema.Attributes)))
+	assert.IsType(t, listschema.StringAttribute{}, resp.Schema.Attributes["catalog_name"])
+}
+
+func TestReadIncludedResource(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=displayName,externalId,entitlements",
+			Response: scim.Group{
+				DisplayName: "Data Scientists",
+				ID:          "abc",
+				Entitlements: []scim.ComplexValue{
+					{
+						Value: "allow-cluster-create",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/def?attributes=displayName,externalId,entitlements",
+			Status:   404,
+			Response: apierr.APIError{
+				ErrorCode: "NOT_FOUND",
+				Message:   "Group def not found",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ListResourceGroup().(*sdkV2ListResource)
+		r.Client = client
+		schemaResp := list.RawV6SchemaResponse{}
+		r.RawV6Schemas(ctx, list.RawV6SchemaRequest{}, &schemaResp)
+		resourceType := schemaResp.ProtoV6Schema.ValueType().(tftypes.Object)
+		server, err := r.server(ctx)
+		require.NoError(t, err)
+
+		state, diags := r.read(ctx, server, resourceType, "abc")
+		require.False(t, diags.HasError(), "%v", diags)
+		require.NotNil(t, state)
+		var attributes map[string]tftypes.Value
+		require.NoError(t, state.As(&attributes))
+		var displayName string
+		require.NoError(t, attributes["display_name"].As(&displayName))
+		assert.Equal(t, "Data Scientists", displayName)
+		var allowClusterCreate bool
+		require.NoError(t, attributes["allow_cluster_create"].As(&allowClusterCreate))
+		assert.True(t, allowClusterCreate)
+
+		state, diags = r.read(ctx, server, resourceType, "def")
+		assert.False(t, diags.HasError(), "%v", diags)
+		assert.Nil(t, state)
+	})
+}
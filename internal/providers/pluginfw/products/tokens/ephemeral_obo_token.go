@@ -0,0 +1,166 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const oboTokenEphemeralResourceName = "obo_token"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &OboTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &OboTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &OboTokenEphemeralResource{}
+
+func EphemeralResourceOboToken() ephemeral.EphemeralResource {
+	return &OboTokenEphemeralResource{}
+}
+
+// OboTokenEphemeralResource is the ephemeral counterpart of databricks_obo_token.
+type OboTokenEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type OboTokenEphemeralModel struct {
+	ApplicationId   types.String `tfsdk:"application_id"`
+	LifetimeSeconds types.Int64  `tfsdk:"lifetime_seconds"`
+	Comment         types.String `tfsdk:"comment"`
+	TokenId         types.String `tfsdk:"token_id"`
+	TokenValue      types.String `tfsdk:"token_value"`
+	ExpiryTime      types.Int64  `tfsdk:"expiry_time"`
+}
+
+func (r *OboTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(oboTokenEphemeralResourceName)
+}
+
+func (r *OboTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an on-behalf-of token for a service principal that is revoked once Terraform no longer needs it",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Application ID of the service principal.",
+			},
+			"lifetime_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of seconds before the token expires.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment that describes the purpose of the token.",
+			},
+			"token_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token_value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiry_time": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *OboTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func (r *OboTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, oboTokenEphemeralResourceName)
+	var model OboTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	renewTime, diags := r.open(ctx, &model, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	resp.RenewAt = renewTime
+}
+
+// open creates the OBO token, fills the computed attributes of the model and returns the time of renewal
+func (r *OboTokenEphemeralResource) open(ctx context.Context, model *OboTokenEphemeralModel, private privateDataSetter) (time.Time, diag.Diagnostics) {
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+	token, err := w.TokenManagement.CreateOboToken(ctx, settings.CreateOboTokenRequest{
+		ApplicationId:   model.ApplicationId.ValueString(),
+		Comment:         model.Comment.ValueString(),
+		LifetimeSeconds: model.LifetimeSeconds.ValueInt64(),
+	})
+	if err != nil {
+		diags.AddError("failed to create OBO token", err.Error())
+		return time.Time{}, diags
+	}
+	model.TokenId = types.StringValue(token.TokenInfo.TokenId)
+	model.TokenValue = types.StringValue(token.TokenValue)
+	model.ExpiryTime = types.Int64Value(token.TokenInfo.ExpiryTime)
+	diags.Append(setCredentialPrivateData(ctx, private, credentialPrivateData{
+		ID:         token.TokenInfo.TokenId,
+		ExpiryTime: token.TokenInfo.ExpiryTime,
+	})...)
+	return renewAt(token.TokenInfo.ExpiryTime), diags
+}
+
+func (r *OboTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, oboTokenEphemeralResourceName)
+	resp.Diagnostics.Append(r.renew(ctx, req.Private)...)
+}
+
+func (r *OboTokenEphemeralResource) renew(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return diags
+	}
+	_, err := w.TokenManagement.GetByTokenId(ctx, data.ID)
+	if err != nil && !apierr.IsMissing(err) {
+		diags.AddError(fmt.Sprintf("failed to read OBO token %s", data.ID), err.Error())
+		return diags
+	}
+	return append(diags, renewCredential("OBO token", data, err == nil)...)
+}
+
+func (r *OboTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, oboTokenEphemeralResourceName)
+	resp.Diagnostics.Append(r.close(ctx, req.Private)...)
+}
+
+// close revokes the OBO token created by open
+func (r *OboTokenEphemeralResource) close(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return diags
+	}
+	err := w.TokenManagement.DeleteByTokenId(ctx, data.ID)
+	if common.IgnoreNotFoundError(err) != nil {
+		diags.AddError(fmt.Sprintf("failed to revoke OBO token %s", data.ID), err.Error())
+	}
+	return diags
+}
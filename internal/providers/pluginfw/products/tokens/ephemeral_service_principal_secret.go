@@ -0,0 +1,254 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const servicePrincipalSecretEphemeralResourceName = "service_principal_secret"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ServicePrincipalSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &ServicePrincipalSecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ServicePrincipalSecretEphemeralResource{}
+
+func EphemeralResourceServicePrincipalSecret() ephemeral.EphemeralResource {
+	return &ServicePrincipalSecretEphemeralResource{}
+}
+
+// ServicePrincipalSecretEphemeralResource is the ephemeral counterpart of databricks_service_principal_secret.
+type ServicePrincipalSecretEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type ServicePrincipalSecretEphemeralModel struct {
+	ServicePrincipalId types.String `tfsdk:"service_principal_id"`
+	Lifetime           types.String `tfsdk:"lifetime"`
+	Api                types.String `tfsdk:"api"`
+	SecretId           types.String `tfsdk:"secret_id"`
+	Secret             types.String `tfsdk:"secret"`
+	SecretHash         types.String `tfsdk:"secret_hash"`
+	CreateTime         types.String `tfsdk:"create_time"`
+	ExpireTime         types.String `tfsdk:"expire_time"`
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(servicePrincipalSecretEphemeralResourceName)
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an OAuth secret for a service principal that is deleted once Terraform no longer needs it",
+		Attributes: map[string]schema.Attribute{
+			"service_principal_id": schema.StringAttribute{
+				Required:    true,
+				Description: "SCIM ID of the service principal.",
+			},
+			"lifetime": schema.StringAttribute{
+				Optional:    true,
+				Description: "The lifetime of the secret in seconds, formatted as `NNNNs`.",
+			},
+			"api": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether to use the account or workspace API. Inferred from the provider configuration when not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.ApiLevelAccount, common.ApiLevelWorkspace),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_hash": schema.StringAttribute{
+				Computed: true,
+			},
+			"create_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"expire_time": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) isAccount(model ServicePrincipalSecretEphemeralModel) bool {
+	switch model.Api.ValueString() {
+	case common.ApiLevelAccount:
+		return true
+	case common.ApiLevelWorkspace:
+		return false
+	default:
+		return r.Client.Config.HostType() == config.AccountHost
+	}
+}
+
+// servicePrincipalSecretsAPI is the subset of the service principal secrets API shared by
+// the account and workspace clients.
+type servicePrincipalSecretsAPI interface {
+	Create(ctx context.Context, request oauth2.CreateServicePrincipalSecretRequest) (*oauth2.CreateServicePrincipalSecretResponse, error)
+	Delete(ctx context.Context, request oauth2.DeleteServicePrincipalSecretRequest) error
+	ListAll(ctx context.Context, request oauth2.ListServicePrincipalSecretsRequest) ([]oauth2.SecretInfo, error)
+}
+
+// secretsAPI returns the service principal secrets API on the account or workspace level.
+func (r *ServicePrincipalSecretEphemeralResource) secretsAPI(ctx context.Context, isAccount bool) (servicePrincipalSecretsAPI, diag.Diagnostics) {
+	if isAccount {
+		a, diags := r.Client.GetAccountClient()
+		if diags.HasError() {
+			return nil, diags
+		}
+		return a.ServicePrincipalSecrets, diags
+	}
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return nil, diags
+	}
+	return w.ServicePrincipalSecretsProxy, diags
+}
+
+// expireTimeMillis converts the RFC3339 expiry time returned by the API into epoch milliseconds.
+func expireTimeMillis(expireTime string) (int64, error) {
+	if expireTime == "" {
+		return 0, nil
+	}
+	t, err := time.Parse(time.RFC3339, expireTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse expire time: %w", err)
+	}
+	return t.UnixMilli(), nil
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, servicePrincipalSecretEphemeralResourceName)
+	var model ServicePrincipalSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	renewTime, diags := r.open(ctx, &model, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	resp.RenewAt = renewTime
+}
+
+// open creates the secret, fills the computed attributes of the model and returns the time of renewal
+func (r *ServicePrincipalSecretEphemeralResource) open(ctx context.Context, model *ServicePrincipalSecretEphemeralModel, private privateDataSetter) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	spId := model.ServicePrincipalId.ValueString()
+	if _, err := strconv.ParseInt(spId, 10, 64); err != nil {
+		diags.AddError("failed to convert service principal ID to numeric", err.Error())
+		return time.Time{}, diags
+	}
+	isAccount := r.isAccount(*model)
+	api, diags := r.secretsAPI(ctx, isAccount)
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+	secret, err := api.Create(ctx, oauth2.CreateServicePrincipalSecretRequest{
+		ServicePrincipalId: spId,
+		Lifetime:           model.Lifetime.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("failed to create service principal secret", err.Error())
+		return time.Time{}, diags
+	}
+	expiry, err := expireTimeMillis(secret.ExpireTime)
+	if err != nil {
+		diags.AddError("failed to create service principal secret", err.Error())
+		return time.Time{}, diags
+	}
+	model.SecretId = types.StringValue(secret.Id)
+	model.Secret = types.StringValue(secret.Secret)
+	model.SecretHash = types.StringValue(secret.SecretHash)
+	model.CreateTime = types.StringValue(secret.CreateTime)
+	model.ExpireTime = types.StringValue(secret.ExpireTime)
+	diags.Append(setCredentialPrivateData(ctx, private, credentialPrivateData{
+		ID:                 secret.Id,
+		ServicePrincipalID: spId,
+		IsAccount:          isAccount,
+		ExpiryTime:         expiry,
+	})...)
+	return renewAt(expiry), diags
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, servicePrincipalSecretEphemeralResourceName)
+	resp.Diagnostics.Append(r.renew(ctx, req.Private)...)
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) renew(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	api, diags := r.secretsAPI(ctx, data.IsAccount)
+	if diags.HasError() {
+		return diags
+	}
+	secrets, err := api.ListAll(ctx, oauth2.ListServicePrincipalSecretsRequest{
+		ServicePrincipalId: data.ServicePrincipalID,
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to read service principal secret %s", data.ID), err.Error())
+		return diags
+	}
+	exists := false
+	for _, secret := range secrets {
+		if secret.Id == data.ID {
+			exists = true
+			break
+		}
+	}
+	return append(diags, renewCredential("service principal secret", data, exists)...)
+}
+
+func (r *ServicePrincipalSecretEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, servicePrincipalSecretEphemeralResourceName)
+	resp.Diagnostics.Append(r.close(ctx, req.Private)...)
+}
+
+// close deletes the secret created by open
+func (r *ServicePrincipalSecretEphemeralResource) close(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	api, diags := r.secretsAPI(ctx, data.IsAccount)
+	if diags.HasError() {
+		return diags
+	}
+	err := api.Delete(ctx, oauth2.DeleteServicePrincipalSecretRequest{
+		SecretId:           data.ID,
+		ServicePrincipalId: data.ServicePrincipalID,
+	})
+	if common.IgnoreNotFoundError(err) != nil {
+		diags.AddError(fmt.Sprintf("failed to delete service principal secret %s", data.ID), err.Error())
+	}
+	return diags
+}
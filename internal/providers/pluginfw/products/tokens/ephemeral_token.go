@@ -0,0 +1,171 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	pluginfwcommon "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/common"
+	pluginfwcontext "github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw/context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const tokenEphemeralResourceName = "token"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TokenEphemeralResource{}
+
+func EphemeralResourceToken() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+// TokenEphemeralResource is the ephemeral counterpart of databricks_token.
+type TokenEphemeralResource struct {
+	Client *common.DatabricksClient
+}
+
+type TokenEphemeralModel struct {
+	LifetimeSeconds types.Int64  `tfsdk:"lifetime_seconds"`
+	Comment         types.String `tfsdk:"comment"`
+	TokenId         types.String `tfsdk:"token_id"`
+	TokenValue      types.String `tfsdk:"token_value"`
+	CreationTime    types.Int64  `tfsdk:"creation_time"`
+	ExpiryTime      types.Int64  `tfsdk:"expiry_time"`
+}
+
+func (r *TokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = pluginfwcommon.GetDatabricksProductionName(tokenEphemeralResourceName)
+}
+
+func (r *TokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a Databricks personal access token that is revoked once Terraform no longer needs it",
+		Attributes: map[string]schema.Attribute{
+			"lifetime_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The lifetime of the token, in seconds. If not specified, the workspace default applies.",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment that describes the purpose of the token.",
+			},
+			"token_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"token_value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"creation_time": schema.Int64Attribute{
+				Computed: true,
+			},
+			"expiry_time": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if r.Client == nil {
+		r.Client = pluginfwcommon.ConfigureEphemeralResource(req, resp)
+	}
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, tokenEphemeralResourceName)
+	var model TokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	renewTime, diags := r.open(ctx, &model, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, model)...)
+	resp.RenewAt = renewTime
+}
+
+// open creates the token, fills the computed attributes of the model and returns the time of renewal
+func (r *TokenEphemeralResource) open(ctx context.Context, model *TokenEphemeralModel, private privateDataSetter) (time.Time, diag.Diagnostics) {
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+	token, err := w.Tokens.Create(ctx, settings.CreateTokenRequest{
+		Comment:         model.Comment.ValueString(),
+		LifetimeSeconds: model.LifetimeSeconds.ValueInt64(),
+	})
+	if err != nil {
+		diags.AddError("failed to create token", err.Error())
+		return time.Time{}, diags
+	}
+	model.TokenId = types.StringValue(token.TokenInfo.TokenId)
+	model.TokenValue = types.StringValue(token.TokenValue)
+	model.CreationTime = types.Int64Value(token.TokenInfo.CreationTime)
+	model.ExpiryTime = types.Int64Value(token.TokenInfo.ExpiryTime)
+	diags.Append(setCredentialPrivateData(ctx, private, credentialPrivateData{
+		ID:         token.TokenInfo.TokenId,
+		ExpiryTime: token.TokenInfo.ExpiryTime,
+	})...)
+	return renewAt(token.TokenInfo.ExpiryTime), diags
+}
+
+func (r *TokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, tokenEphemeralResourceName)
+	resp.Diagnostics.Append(r.renew(ctx, req.Private)...)
+}
+
+func (r *TokenEphemeralResource) renew(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return diags
+	}
+	tokenList, err := w.Tokens.ListAll(ctx)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("failed to read token %s", data.ID), err.Error())
+		return diags
+	}
+	exists := false
+	for _, token := range tokenList {
+		if token.TokenId == data.ID {
+			exists = true
+			break
+		}
+	}
+	return append(diags, renewCredential("token", data, exists)...)
+}
+
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = pluginfwcontext.SetUserAgentInEphemeralResourceContext(ctx, tokenEphemeralResourceName)
+	resp.Diagnostics.Append(r.close(ctx, req.Private)...)
+}
+
+// close revokes the token created by open
+func (r *TokenEphemeralResource) close(ctx context.Context, private privateDataGetter) diag.Diagnostics {
+	data, diags := getCredentialPrivateData(ctx, private)
+	if diags.HasError() || data == nil {
+		return diags
+	}
+	w, diags := r.Client.GetWorkspaceClientForUnifiedProviderWithDiagnostics(ctx, "")
+	if diags.HasError() {
+		return diags
+	}
+	err := w.Tokens.Delete(ctx, settings.RevokeTokenRequest{TokenId: data.ID})
+	if common.IgnoreNotFoundError(err) != nil {
+		diags.AddError(fmt.Sprintf("failed to revoke token %s", data.ID), err.Error())
+	}
+	return diags
+}
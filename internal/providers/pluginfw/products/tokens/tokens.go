@@ -0,0 +1,89 @@
+// Package tokens contains ephemeral resources that mint short-lived Databricks credentials.
+//
+// Unlike databricks_token, databricks_obo_token and databricks_service_principal_secret,
+// the credentials produced here are never persisted in the Terraform state: they are created
+// when the ephemeral resource is opened and revoked when Terraform closes it.
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateDataKey is the key under which the identifiers of the minted credential are kept
+// in the private data of the ephemeral resource, so that Renew and Close can find it.
+const privateDataKey = "credential"
+
+// renewBeforeExpiry is the margin before credential expiry at which Terraform is asked to renew it.
+const renewBeforeExpiry = 5 * time.Minute
+
+// credentialPrivateData identifies a credential minted by one of the ephemeral resources.
+type credentialPrivateData struct {
+	ID                 string `json:"id"`
+	ServicePrincipalID string `json:"service_principal_id,omitempty"`
+	IsAccount          bool   `json:"is_account,omitempty"`
+	// ExpiryTime is in epoch milliseconds, 0 if the credential doesn't expire.
+	ExpiryTime int64 `json:"expiry_time,omitempty"`
+}
+
+type privateDataSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateDataGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func setCredentialPrivateData(ctx context.Context, private privateDataSetter, data credentialPrivateData) diag.Diagnostics {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("failed to encode private data", err.Error())}
+	}
+	return private.SetKey(ctx, privateDataKey, raw)
+}
+
+// getCredentialPrivateData returns the credential stored in the private data, or nil if nothing was stored.
+func getCredentialPrivateData(ctx context.Context, private privateDataGetter) (*credentialPrivateData, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, privateDataKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+	var data credentialPrivateData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		diags.AddError("failed to decode private data", err.Error())
+		return nil, diags
+	}
+	return &data, diags
+}
+
+// renewAt returns the time at which Terraform should call Renew for a credential expiring at the
+// given time (epoch milliseconds). Zero time means the credential never needs renewal.
+func renewAt(expiryTimeMillis int64) time.Time {
+	if expiryTimeMillis <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(expiryTimeMillis).Add(-renewBeforeExpiry)
+}
+
+// renewCredential checks that a credential is still valid. Databricks doesn't support extending the
+// lifetime of PATs and OAuth secrets, so the best Renew can do is to fail early when the credential
+// has been revoked, and warn when it is about to expire while still in use.
+func renewCredential(kind string, data *credentialPrivateData, exists bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !exists {
+		diags.AddError(fmt.Sprintf("%s is no longer valid", kind),
+			fmt.Sprintf("%s with id %s was revoked or has expired", kind, data.ID))
+		return diags
+	}
+	if data.ExpiryTime > 0 {
+		diags.AddWarning(fmt.Sprintf("%s is about to expire", kind),
+			fmt.Sprintf("%s with id %s expires at %s and its lifetime cannot be extended. Increase its lifetime "+
+				"if it needs to be valid for the whole Terraform run.", kind, data.ID,
+				time.UnixMilli(data.ExpiryTime).UTC().Format(time.RFC3339)))
+	}
+	return diags
+}
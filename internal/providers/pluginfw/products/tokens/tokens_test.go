@@ -0,0 +1,333 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/oauth2"
+	"github.com/databricks/databricks-sdk-go/service/settings"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mapPrivateData map[string][]byte
+
+func (m mapPrivateData) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	m[key] = value
+	return nil
+}
+
+func (m mapPrivateData) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func TestCredentialPrivateData_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	private := mapPrivateData{}
+	expected := credentialPrivateData{
+		ID:                 "abc",
+		ServicePrincipalID: "123",
+		IsAccount:          true,
+		ExpiryTime:         1700000000000,
+	}
+	diags := setCredentialPrivateData(ctx, private, expected)
+	require.False(t, diags.HasError())
+
+	actual, diags := getCredentialPrivateData(ctx, private)
+	require.False(t, diags.HasError())
+	require.NotNil(t, actual)
+	assert.Equal(t, expected, *actual)
+}
+
+func TestCredentialPrivateData_Missing(t *testing.T) {
+	actual, diags := getCredentialPrivateData(context.Background(), mapPrivateData{})
+	assert.False(t, diags.HasError())
+	assert.Nil(t, actual)
+}
+
+func TestCredentialPrivateData_Invalid(t *testing.T) {
+	private := mapPrivateData{privateDataKey: []byte("not json")}
+	_, diags := getCredentialPrivateData(context.Background(), private)
+	assert.True(t, diags.HasError())
+}
+
+func TestRenewAt(t *testing.T) {
+	assert.True(t, renewAt(0).IsZero())
+	assert.True(t, renewAt(-1).IsZero())
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, expiry.Add(-renewBeforeExpiry), renewAt(expiry.UnixMilli()).UTC())
+}
+
+func TestRenewCredential(t *testing.T) {
+	data := &credentialPrivateData{ID: "abc"}
+	diags := renewCredential("token", data, false)
+	assert.True(t, diags.HasError())
+
+	diags = renewCredential("token", data, true)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, diags.WarningsCount())
+
+	data.ExpiryTime = time.Now().Add(time.Minute).UnixMilli()
+	diags = renewCredential("token", data, true)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+}
+
+func TestExpireTimeMillis(t *testing.T) {
+	millis, err := expireTimeMillis("")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), millis)
+
+	millis, err = expireTimeMillis("2030-01-01T00:00:00Z")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli(), millis)
+
+	_, err = expireTimeMillis("tomorrow")
+	assert.Error(t, err)
+}
+
+func TestTokenOpenClose(t *testing.T) {
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockTokensAPI().EXPECT()
+		e.Create(mock.Anything, settings.CreateTokenRequest{
+			Comment:         "ci",
+			LifetimeSeconds: 3600,
+		}).Return(&settings.CreateTokenResponse{
+			TokenInfo: &settings.PublicTokenInfo{
+				TokenId:      "abc",
+				CreationTime: 1700000000000,
+				ExpiryTime:   expiry,
+			},
+			TokenValue: "dapi123",
+		}, nil)
+		e.ListAll(mock.Anything).Return([]settings.PublicTokenInfo{{TokenId: "abc"}}, nil)
+		e.Delete(mock.Anything, settings.RevokeTokenRequest{TokenId: "abc"}).Return(nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		model := TokenEphemeralModel{
+			Comment:         types.StringValue("ci"),
+			LifetimeSeconds: types.Int64Value(3600),
+		}
+		renewTime, diags := r.open(ctx, &model, private)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "abc", model.TokenId.ValueString())
+		assert.Equal(t, "dapi123", model.TokenValue.ValueString())
+		assert.Equal(t, expiry, model.ExpiryTime.ValueInt64())
+		assert.Equal(t, renewAt(expiry), renewTime)
+
+		diags = r.renew(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+
+		diags = r.close(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+}
+
+func TestTokenOpenError(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokensAPI().EXPECT().Create(mock.Anything, settings.CreateTokenRequest{}).
+			Return(nil, fmt.Errorf("quota exceeded"))
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		_, diags := r.open(ctx, &TokenEphemeralModel{}, private)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "failed to create token", diags[0].Summary())
+		assert.Empty(t, private)
+	})
+}
+
+func TestTokenCloseRevokeError(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokensAPI().EXPECT().Delete(mock.Anything, settings.RevokeTokenRequest{TokenId: "abc"}).
+			Return(fmt.Errorf("internal error"))
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		setCredentialPrivateData(ctx, private, credentialPrivateData{ID: "abc"})
+		diags := r.close(ctx, private)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "failed to revoke token abc", diags[0].Summary())
+		assert.Equal(t, "internal error", diags[0].Detail())
+	})
+}
+
+func TestTokenCloseAlreadyRevoked(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokensAPI().EXPECT().Delete(mock.Anything, settings.RevokeTokenRequest{TokenId: "abc"}).
+			Return(&apierr.APIError{
+				ErrorCode:  "RESOURCE_DOES_NOT_EXIST",
+				StatusCode: 404,
+				Message:    "Token abc does not exist",
+			})
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		setCredentialPrivateData(ctx, private, credentialPrivateData{ID: "abc"})
+		diags := r.close(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+}
+
+func TestTokenCloseWithoutOpen(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		diags := r.close(ctx, mapPrivateData{})
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+}
+
+func TestTokenRenewRevoked(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokensAPI().EXPECT().ListAll(mock.Anything).Return([]settings.PublicTokenInfo{{TokenId: "def"}}, nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &TokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		setCredentialPrivateData(ctx, private, credentialPrivateData{ID: "abc"})
+		diags := r.renew(ctx, private)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "token is no longer valid", diags[0].Summary())
+	})
+}
+
+func TestOboTokenOpenClose(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockTokenManagementAPI().EXPECT()
+		e.CreateOboToken(mock.Anything, settings.CreateOboTokenRequest{
+			ApplicationId:   "app-id",
+			LifetimeSeconds: 600,
+		}).Return(&settings.CreateOboTokenResponse{
+			TokenInfo: &settings.TokenInfo{
+				TokenId: "abc",
+			},
+			TokenValue: "dapi123",
+		}, nil)
+		e.GetByTokenId(mock.Anything, "abc").Return(&settings.GetTokenResponse{}, nil)
+		e.DeleteByTokenId(mock.Anything, "abc").Return(nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &OboTokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		model := OboTokenEphemeralModel{
+			ApplicationId:   types.StringValue("app-id"),
+			LifetimeSeconds: types.Int64Value(600),
+		}
+		renewTime, diags := r.open(ctx, &model, private)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "abc", model.TokenId.ValueString())
+		assert.Equal(t, "dapi123", model.TokenValue.ValueString())
+		assert.True(t, renewTime.IsZero())
+
+		diags = r.renew(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+
+		diags = r.close(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+}
+
+func TestOboTokenCloseRevokeError(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		w.GetMockTokenManagementAPI().EXPECT().DeleteByTokenId(mock.Anything, "abc").
+			Return(fmt.Errorf("permission denied"))
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &OboTokenEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		setCredentialPrivateData(ctx, private, credentialPrivateData{ID: "abc"})
+		diags := r.close(ctx, private)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "failed to revoke OBO token abc", diags[0].Summary())
+	})
+}
+
+func TestServicePrincipalSecretOpenCloseWorkspace(t *testing.T) {
+	qa.MockWorkspaceApply(t, func(w *mocks.MockWorkspaceClient) {
+		e := w.GetMockServicePrincipalSecretsProxyAPI().EXPECT()
+		e.Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+			ServicePrincipalId: "123",
+			Lifetime:           "3600s",
+		}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+			Id:         "secret-id",
+			Secret:     "dose123",
+			ExpireTime: "2030-01-01T00:00:00Z",
+		}, nil)
+		e.Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+			ServicePrincipalId: "123",
+			SecretId:           "secret-id",
+		}).Return(nil)
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &ServicePrincipalSecretEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		model := ServicePrincipalSecretEphemeralModel{
+			ServicePrincipalId: types.StringValue("123"),
+			Lifetime:           types.StringValue("3600s"),
+			Api:                types.StringValue(common.ApiLevelWorkspace),
+		}
+		renewTime, diags := r.open(ctx, &model, private)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "secret-id", model.SecretId.ValueString())
+		assert.Equal(t, "dose123", model.Secret.ValueString())
+		expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+		assert.Equal(t, renewAt(expiry), renewTime)
+
+		diags = r.close(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+	})
+}
+
+func TestServicePrincipalSecretOpenCloseAccount(t *testing.T) {
+	qa.MockAccountsApply(t, func(a *mocks.MockAccountClient) {
+		e := a.GetMockServicePrincipalSecretsAPI().EXPECT()
+		e.Create(mock.Anything, oauth2.CreateServicePrincipalSecretRequest{
+			ServicePrincipalId: "123",
+		}).Return(&oauth2.CreateServicePrincipalSecretResponse{
+			Id:     "secret-id",
+			Secret: "dose123",
+		}, nil)
+		e.ListAll(mock.Anything, oauth2.ListServicePrincipalSecretsRequest{
+			ServicePrincipalId: "123",
+		}).Return([]oauth2.SecretInfo{{Id: "secret-id"}}, nil)
+		e.Delete(mock.Anything, oauth2.DeleteServicePrincipalSecretRequest{
+			ServicePrincipalId: "123",
+			SecretId:           "secret-id",
+		}).Return(fmt.Errorf("internal error"))
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := &ServicePrincipalSecretEphemeralResource{Client: client}
+		private := mapPrivateData{}
+		model := ServicePrincipalSecretEphemeralModel{
+			ServicePrincipalId: types.StringValue("123"),
+			Api:                types.StringValue(common.ApiLevelAccount),
+		}
+		_, diags := r.open(ctx, &model, private)
+		require.False(t, diags.HasError(), "%v", diags)
+		data, _ := getCredentialPrivateData(ctx, private)
+		require.NotNil(t, data)
+		assert.True(t, data.IsAccount)
+
+		diags = r.renew(ctx, private)
+		assert.False(t, diags.HasError(), "%v", diags)
+
+		diags = r.close(ctx, private)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "failed to delete service principal secret secret-id", diags[0].Summary())
+	})
+}
+
+func TestServicePrincipalSecretOpenInvalidId(t *testing.T) {
+	r := &ServicePrincipalSecretEphemeralResource{}
+	_, diags := r.open(context.Background(), &ServicePrincipalSecretEphemeralModel{
+		ServicePrincipalId: types.StringValue("abc"),
+	}, mapPrivateData{})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "failed to convert service principal ID to numeric", diags[0].Summary())
+}
@@ -0,0 +1,223 @@
+package pluginfw
+
+import (
+	"context"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// wrapResources wraps resources, so they refuse creation, update and deletion when the provider is
+// configured with read_only = true, refuse deletion when deletion_protection is enabled, and their API
+// requests are recorded in the audit log. Optional interfaces implemented by resources are kept.
+func wrapResources(resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(resources))
+	for _, resourceFunc := range resources {
+		wrapped = append(wrapped, func() resource.Resource {
+			return newWrappedResource(resourceFunc())
+		})
+	}
+	return wrapped
+}
+
+func newWrappedResource(r resource.Resource) resource.Resource {
+	base := &wrappedResource{Resource: r}
+	_, importable := r.(resource.ResourceWithImportState)
+	_, planModifiable := r.(resource.ResourceWithModifyPlan)
+	switch {
+	case importable && planModifiable:
+		return wrappedResourceWithImportStateAndModifyPlan{base}
+	case importable:
+		return wrappedResourceWithImportState{base}
+	case planModifiable:
+		return wrappedResourceWithModifyPlan{base}
+	default:
+		return base
+	}
+}
+
+type wrappedResource struct {
+	resource.Resource
+	client *common.DatabricksClient
+}
+
+func (r *wrappedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if configurable, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+	if client, ok := req.ProviderData.(*common.DatabricksClient); ok {
+		r.client = client
+	}
+}
+
+func (r *wrappedResource) typeName(ctx context.Context) string {
+	metadata := resource.MetadataResponse{}
+	r.Resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "databricks"}, &metadata)
+	return metadata.TypeName
+}
+
+// refuse adds an error to diagnostics if the provider is in read-only mode
+func (r *wrappedResource) refuse(ctx context.Context, operation string, diags *diag.Diagnostics) bool {
+	if !r.client.IsReadOnly() {
+		return false
+	}
+	diags.AddError("Provider is in read-only mode", common.ReadOnlyError(operation, r.typeName(ctx)).Error())
+	return true
+}
+
+// audited returns context, where API requests are recorded in the audit log with the given CRUD phase
+// and ID of the resource from its state, if the resource has the id attribute
+func (r *wrappedResource) audited(ctx context.Context, phase string, state *tfsdk.State) context.Context {
+	schemaResp := resource.SchemaResponse{}
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	info := common.AuditInfo{
+		ResourceType:    r.typeName(ctx),
+		Phase:           phase,
+		SensitiveFields: sensitiveAttributeNames(schemaResp.Schema.Attributes, schemaResp.Schema.Blocks),
+	}
+	if _, ok := schemaResp.Schema.Attributes["id"]; ok && state != nil && !state.Raw.IsNull() {
+		var id types.String
+		if diags := state.GetAttribute(ctx, path.Root("id"), &id); !diags.HasError() {
+			info.ResourceID = id.ValueString()
+		}
+	}
+	return common.WithAuditInfo(ctx, info)
+}
+
+// sensitiveAttributeNames returns names of all sensitive attributes, including nested ones
+func sensitiveAttributeNames(attributes map[string]schema.Attribute, blocks map[string]schema.Block) map[string]bool {
+	names := map[string]bool{}
+	add := func(nested map[string]bool) {
+		for name := range nested {
+			names[name] = true
+		}
+	}
+	for name, attribute := range attributes {
+		if attribute.IsSensitive() {
+			names[name] = true
+		}
+		switch a := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			add(sensitiveAttributeNames(a.Attributes, nil))
+		case schema.ListNestedAttribute:
+			add(sensitiveAttributeNames(a.NestedObject.Attributes, nil))
+		case schema.SetNestedAttribute:
+			add(sensitiveAttributeNames(a.NestedObject.Attributes, nil))
+		case schema.MapNestedAttribute:
+			add(sensitiveAttributeNames(a.NestedObject.Attributes, nil))
+		}
+	}
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case schema.SingleNestedBlock:
+			add(sensitiveAttributeNames(b.Attributes, b.Blocks))
+		case schema.ListNestedBlock:
+			add(sensitiveAttributeNames(b.NestedObject.Attributes, b.NestedObject.Blocks))
+		case schema.SetNestedBlock:
+			add(sensitiveAttributeNames(b.NestedObject.Attributes, b.NestedObject.Blocks))
+		}
+	}
+	return names
+}
+
+func (r *wrappedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.refuse(ctx, "create", &resp.Diagnostics) {
+		return
+	}
+	r.Resource.Create(r.audited(ctx, "create", nil), req, resp)
+}
+
+func (r *wrappedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.Resource.Read(r.audited(ctx, "read", &req.State), req, resp)
+}
+
+func (r *wrappedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.refuse(ctx, "update", &resp.Diagnostics) {
+		return
+	}
+	if onlyDeletionProtectionChanged(req.State, req.Plan) {
+		// there's nothing to update in Databricks
+		resp.State.Raw = req.Plan.Raw
+		return
+	}
+	r.Resource.Update(r.audited(ctx, "update", &req.State), req, resp)
+}
+
+// deletionProtected returns true if the resource has deletion_protection set to true in the state
+func deletionProtected(ctx context.Context, state tfsdk.State) bool {
+	if _, ok := state.Schema.GetAttributes()[common.DeletionProtectionAttribute]; !ok || state.Raw.IsNull() {
+		return false
+	}
+	var enabled types.Bool
+	if diags := state.GetAttribute(ctx, path.Root(common.DeletionProtectionAttribute), &enabled); diags.HasError() {
+		return false
+	}
+	return enabled.ValueBool()
+}
+
+// onlyDeletionProtectionChanged returns true if deletion_protection is the only attribute that differs
+// between the state and the plan
+func onlyDeletionProtectionChanged(state tfsdk.State, plan tfsdk.Plan) bool {
+	if _, ok := state.Schema.GetAttributes()[common.DeletionProtectionAttribute]; !ok {
+		return false
+	}
+	var before, after map[string]tftypes.Value
+	if state.Raw.As(&before) != nil || plan.Raw.As(&after) != nil || len(before) != len(after) {
+		return false
+	}
+	for name, value := range after {
+		if name == common.DeletionProtectionAttribute {
+			continue
+		}
+		if !value.Equal(before[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *wrappedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.refuse(ctx, "delete", &resp.Diagnostics) {
+		return
+	}
+	if deletionProtected(ctx, req.State) {
+		resp.Diagnostics.AddError("Resource is protected from deletion",
+			common.DeletionProtectionError(r.typeName(ctx)).Error())
+		return
+	}
+	r.Resource.Delete(r.audited(ctx, "delete", &req.State), req, resp)
+}
+
+type wrappedResourceWithImportState struct {
+	*wrappedResource
+}
+
+func (r wrappedResourceWithImportState) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.Resource.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+}
+
+type wrappedResourceWithModifyPlan struct {
+	*wrappedResource
+}
+
+func (r wrappedResourceWithModifyPlan) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.Resource.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+}
+
+type wrappedResourceWithImportStateAndModifyPlan struct {
+	*wrappedResource
+}
+
+func (r wrappedResourceWithImportStateAndModifyPlan) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.Resource.(resource.ResourceWithImportState).ImportState(ctx, req, resp)
+}
+
+func (r wrappedResourceWithImportStateAndModifyPlan) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.Resource.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, resp)
+}
@@ -0,0 +1,210 @@
+package pluginfw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResource struct {
+	created   bool
+	auditInfo []common.AuditInfo
+}
+
+func (r *fakeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fake"
+}
+
+func (r *fakeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"password": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+						"user": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fakeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.created = true
+	info, _ := common.AuditInfoFromContext(ctx)
+	r.auditInfo = append(r.auditInfo, info)
+}
+
+func (r *fakeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *fakeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *fakeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func TestWrappedResourceKeepsOptionalInterfaces(t *testing.T) {
+	for _, resourceFunc := range getPluginFrameworkResourcesToRegister(nil) {
+		original := resourceFunc()
+		wrapped := newWrappedResource(original)
+		name := getResourceName(resourceFunc)
+		_, ok := original.(resource.ResourceWithImportState)
+		_, wrappedOk := wrapped.(resource.ResourceWithImportState)
+		assert.Equal(t, ok, wrappedOk, "%s: ImportState", name)
+		_, ok = original.(resource.ResourceWithModifyPlan)
+		_, wrappedOk = wrapped.(resource.ResourceWithModifyPlan)
+		assert.Equal(t, ok, wrappedOk, "%s: ModifyPlan", name)
+
+		// other optional interfaces have to be supported by newWrappedResource before they're used
+		_, ok = original.(resource.ResourceWithConfigValidators)
+		assert.False(t, ok, "%s: ConfigValidators", name)
+		_, ok = original.(resource.ResourceWithValidateConfig)
+		assert.False(t, ok, "%s: ValidateConfig", name)
+		_, ok = original.(resource.ResourceWithUpgradeState)
+		assert.False(t, ok, "%s: UpgradeState", name)
+		_, ok = original.(resource.ResourceWithMoveState)
+		assert.False(t, ok, "%s: MoveState", name)
+		_, ok = original.(resource.ResourceWithIdentity)
+		assert.False(t, ok, "%s: Identity", name)
+	}
+}
+
+func TestWrappedResourceRefusesCreateInReadOnlyMode(t *testing.T) {
+	fake := &fakeResource{}
+	r := newWrappedResource(fake).(resource.ResourceWithConfigure)
+	client := &common.DatabricksClient{}
+	client.SetReadOnly(true)
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+
+	resp := &resource.CreateResponse{}
+	r.Create(context.Background(), resource.CreateRequest{}, resp)
+	assert.False(t, fake.created)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "cannot create databricks_fake: the provider is configured with read_only = true",
+		resp.Diagnostics[0].Detail())
+
+	client.SetReadOnly(false)
+	resp = &resource.CreateResponse{}
+	r.Create(context.Background(), resource.CreateRequest{}, resp)
+	assert.True(t, fake.created)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+func TestWrappedResourceAuditInfo(t *testing.T) {
+	fake := &fakeResource{}
+	newWrappedResource(fake).Create(context.Background(), resource.CreateRequest{}, &resource.CreateResponse{})
+	assert.Equal(t, []common.AuditInfo{
+		{
+			ResourceType:    "databricks_fake",
+			Phase:           "create",
+			SensitiveFields: map[string]bool{"token": true, "password": true},
+		},
+	}, fake.auditInfo)
+}
+
+type protectedResource struct {
+	fakeResource
+	updated bool
+	deleted bool
+}
+
+var protectedResourceType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"name":                tftypes.String,
+		"deletion_protection": tftypes.Bool,
+	},
+}
+
+func (r *protectedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *protectedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.updated = true
+}
+
+func (r *protectedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.deleted = true
+}
+
+func (r *protectedResource) value(name string, deletionProtection bool) tftypes.Value {
+	return tftypes.NewValue(protectedResourceType, map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, name),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, deletionProtection),
+	})
+}
+
+func (r *protectedResource) state(name string, deletionProtection bool) tfsdk.State {
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	return tfsdk.State{Schema: resp.Schema, Raw: r.value(name, deletionProtection)}
+}
+
+func (r *protectedResource) plan(name string, deletionProtection bool) tfsdk.Plan {
+	state := r.state(name, deletionProtection)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestWrappedResourceRefusesDeleteWithDeletionProtection(t *testing.T) {
+	protected := &protectedResource{}
+	r := newWrappedResource(protected)
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: protected.state("a", true)}, resp)
+	assert.False(t, protected.deleted)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "cannot delete databricks_fake: deletion_protection is enabled, set deletion_protection = false "+
+		"and apply it before deleting the resource", resp.Diagnostics[0].Detail())
+
+	resp = &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: protected.state("a", false)}, resp)
+	assert.True(t, protected.deleted)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+func TestWrappedResourceSkipsUpdateOfDeletionProtection(t *testing.T) {
+	protected := &protectedResource{}
+	r := newWrappedResource(protected)
+
+	resp := &resource.UpdateResponse{State: protected.state("a", true)}
+	r.Update(context.Background(), resource.UpdateRequest{
+		State: protected.state("a", true),
+		Plan:  protected.plan("a", false),
+	}, resp)
+	assert.False(t, protected.updated)
+	assert.True(t, resp.State.Raw.Equal(protected.value("a", false)))
+
+	r.Update(context.Background(), resource.UpdateRequest{
+		State: protected.state("a", true),
+		Plan:  protected.plan("b", false),
+	}, &resource.UpdateResponse{})
+	assert.True(t, protected.updated)
+}
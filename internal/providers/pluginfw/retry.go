@@ -0,0 +1,128 @@
+package pluginfw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// retryBlock returns the schema of the retry block of the provider, which must be the same as in SDKv2 provider
+func retryBlock() schema.Block {
+	overrideAttributes := retrySettingsAttributes()
+	overrideAttributes["resource_types"] = schema.ListAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+	}
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: retrySettingsAttributes(),
+			Blocks: map[string]schema.Block{
+				"override": schema.ListNestedBlock{
+					NestedObject: schema.NestedBlockObject{
+						Attributes: overrideAttributes,
+					},
+				},
+			},
+		},
+	}
+}
+
+func retrySettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"max_attempts": schema.Int64Attribute{
+			Optional: true,
+		},
+		"min_backoff": schema.StringAttribute{
+			Optional: true,
+		},
+		"max_backoff": schema.StringAttribute{
+			Optional: true,
+		},
+		"retriable_status_codes": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.Int64Type,
+		},
+		"retriable_error_codes": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+type retrySettingsModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff           types.String `tfsdk:"min_backoff"`
+	MaxBackoff           types.String `tfsdk:"max_backoff"`
+	RetriableStatusCodes types.List   `tfsdk:"retriable_status_codes"`
+	RetriableErrorCodes  types.List   `tfsdk:"retriable_error_codes"`
+}
+
+type retryOverrideModel struct {
+	retrySettingsModel
+	ResourceTypes types.List `tfsdk:"resource_types"`
+}
+
+type retryModel struct {
+	retrySettingsModel
+	Overrides []retryOverrideModel `tfsdk:"override"`
+}
+
+func (m retrySettingsModel) toSettings(ctx context.Context) (common.RetrySettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := common.RetrySettings{
+		MaxAttempts: int(m.MaxAttempts.ValueInt64()),
+		MinBackoff:  m.MinBackoff.ValueString(),
+		MaxBackoff:  m.MaxBackoff.ValueString(),
+	}
+	if !m.RetriableStatusCodes.IsNull() && !m.RetriableStatusCodes.IsUnknown() {
+		var codes []int64
+		diags.Append(m.RetriableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		for _, code := range codes {
+			settings.RetriableStatusCodes = append(settings.RetriableStatusCodes, int(code))
+		}
+	}
+	if !m.RetriableErrorCodes.IsNull() && !m.RetriableErrorCodes.IsUnknown() {
+		diags.Append(m.RetriableErrorCodes.ElementsAs(ctx, &settings.RetriableErrorCodes, false)...)
+	}
+	return settings, diags
+}
+
+// retryPolicyFromConfig returns the retry policy from the retry block of the provider configuration,
+// or nil if the block isn't specified
+func retryPolicyFromConfig(ctx context.Context, providerConfig tfsdk.Config) (*common.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var blocks []retryModel
+	diags.Append(providerConfig.GetAttribute(ctx, path.Root("retry"), &blocks)...)
+	if diags.HasError() || len(blocks) == 0 {
+		return nil, diags
+	}
+	if len(blocks) > 1 {
+		diags.AddError("Invalid retry configuration", fmt.Sprintf("only one retry block is allowed, got %d", len(blocks)))
+		return nil, diags
+	}
+	settings, d := blocks[0].toSettings(ctx)
+	diags.Append(d...)
+	var overrides []common.RetryOverride
+	for _, o := range blocks[0].Overrides {
+		override := common.RetryOverride{}
+		override.RetrySettings, d = o.toSettings(ctx)
+		diags.Append(d...)
+		diags.Append(o.ResourceTypes.ElementsAs(ctx, &override.ResourceTypes, false)...)
+		overrides = append(overrides, override)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	policy, err := common.NewRetryPolicy(settings, overrides)
+	if err != nil {
+		diags.AddError("Invalid retry configuration", err.Error())
+		return nil, diags
+	}
+	return policy, diags
+}
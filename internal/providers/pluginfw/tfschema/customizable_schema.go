@@ -212,6 +212,18 @@ func (s *CustomizableSchema) ConfigureAsSdkV2Compatible() *CustomizableSchema {
 	return s
 }
 
+// AddDeletionProtection adds the optional `deletion_protection` attribute to the top level of the schema. Deletion of
+// resources with this attribute set to true in the state is refused by the provider. The model of the resource must
+// have the corresponding `DeletionProtection types.Bool` field with `tfsdk:"deletion_protection"` tag.
+func (s *CustomizableSchema) AddDeletionProtection() *CustomizableSchema {
+	attributes := attributeToNestedBlockObject(&s.attr).Attributes
+	if _, ok := attributes[common.DeletionProtectionAttribute]; ok {
+		panic(fmt.Errorf("%s is already defined in the schema. %s", common.DeletionProtectionAttribute, common.TerraformBugErrorMessage))
+	}
+	attributes[common.DeletionProtectionAttribute] = BoolAttributeBuilder{Optional: true}
+	return s
+}
+
 // navigateSchemaWithCallback navigates through schema attributes and executes callback on the target, panics if path does not exist or invalid.
 func navigateSchemaWithCallback(s *BaseSchemaBuilder, cb func(BaseSchemaBuilder) BaseSchemaBuilder, path ...string) {
 	currentScm := s
@@ -144,6 +144,26 @@ func TestCustomizeSchemaSetReadOnly_RecursivelySetsFieldsOfListNestedAttributes(
 	}
 }
 
+func TestCustomizeSchemaAddDeletionProtection(t *testing.T) {
+	scm := ResourceStructToSchema(context.Background(), TestTfSdk{}, func(c CustomizableSchema) CustomizableSchema {
+		c.AddDeletionProtection()
+		return c
+	})
+	assert.True(t, scm.Attributes["deletion_protection"].IsOptional())
+	assert.False(t, scm.Attributes["deletion_protection"].IsComputed())
+	assert.IsType(t, schema.BoolAttribute{}, scm.Attributes["deletion_protection"])
+}
+
+func TestCustomizeSchemaAddDeletionProtection_PanicOnDuplicate(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = ResourceStructToSchema(context.Background(), TestTfSdk{}, func(c CustomizableSchema) CustomizableSchema {
+			c.AddDeletionProtection()
+			c.AddDeletionProtection()
+			return c
+		})
+	})
+}
+
 func TestCustomizeSchemaAddValidator(t *testing.T) {
 	scm := ResourceStructToSchema(context.Background(), TestTfSdk{}, func(c CustomizableSchema) CustomizableSchema {
 		c.AddValidator(stringLengthBetweenValidator{}, "description")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/sdkv2"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -448,6 +449,42 @@ type parseUserAgentTestCase struct {
 	out  []sdkv2.UserAgentExtra
 }
 
+func TestConfig_Retry(t *testing.T) {
+	providerFixture{
+		host:  "https://x",
+		token: "x",
+		retry: &providerRetryFixture{
+			maxAttempts: 5,
+			minBackoff:  "2s",
+		},
+		assertAuth:  "pat",
+		assertHost:  "https://x",
+		assertRetry: true,
+	}.apply(t)
+}
+
+func TestConfig_RetryInvalidBackoff(t *testing.T) {
+	providerFixture{
+		host:  "https://x",
+		token: "x",
+		retry: &providerRetryFixture{
+			minBackoff: "1m",
+		},
+		assertError: "min_backoff (1m0s) must be positive and not greater than max_backoff (30s)",
+	}.apply(t)
+}
+
+func TestConfig_ReadOnly(t *testing.T) {
+	providerFixture{
+		host:           "https://x",
+		token:          "x",
+		readOnly:       true,
+		assertAuth:     "pat",
+		assertHost:     "https://x",
+		assertReadOnly: true,
+	}.apply(t)
+}
+
 func Test_ParseUserAgentExtra(t *testing.T) {
 	testCases := []parseUserAgentTestCase{
 		{
@@ -512,3 +549,21 @@ func Test_ParseUserAgentExtra(t *testing.T) {
 		})
 	}
 }
+
+func TestListResourcesHaveManagedResourcesWithIdentity(t *testing.T) {
+	ctx := context.Background()
+	server, err := GetProviderServer(ctx)
+	require.NoError(t, err)
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range schemaResp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+	identityResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, schemaResp.ListResourceSchemas)
+	for name := range schemaResp.ListResourceSchemas {
+		assert.Contains(t, schemaResp.ResourceSchemas, name)
+		assert.Contains(t, identityResp.IdentitySchemas, name)
+	}
+}
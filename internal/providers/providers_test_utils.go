@@ -31,12 +31,22 @@ type providerFixture struct {
 	azureResourceID   string
 	authType          string
 	scopes            []string
+	retry             *providerRetryFixture
+	readOnly          bool
 	env               map[string]string
 	assertError       string
 	assertAuth        string
 	assertHost        string
 	assertAzure       bool
 	assertScopes      []string
+	assertRetry       bool
+	assertReadOnly    bool
+}
+
+// providerRetryFixture is a retry block of the provider configuration
+type providerRetryFixture struct {
+	maxAttempts int
+	minBackoff  string
 }
 
 const testDataPath = "../../common/testdata"
@@ -94,6 +104,17 @@ func (pf providerFixture) rawConfigSDKv2() map[string]any {
 		}
 		rawConfigSDKv2["scopes"] = scopesInterface
 	}
+	if pf.readOnly {
+		rawConfigSDKv2["read_only"] = true
+	}
+	if pf.retry != nil {
+		rawConfigSDKv2["retry"] = []any{
+			map[string]any{
+				"max_attempts": pf.retry.maxAttempts,
+				"min_backoff":  pf.retry.minBackoff,
+			},
+		}
+	}
 	return rawConfigSDKv2
 }
 
@@ -120,6 +141,44 @@ func (pf providerFixture) rawConfigPluginFramework() tftypes.Value {
 		}
 		rawConfigValueMap["scopes"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, scopeValues)
 	}
+	if pf.readOnly {
+		rawConfigTypeMap["read_only"] = tftypes.Bool
+		rawConfigValueMap["read_only"] = tftypes.NewValue(tftypes.Bool, true)
+	}
+	if pf.retry != nil {
+		settingsTypes := map[string]tftypes.Type{
+			"max_attempts":           tftypes.Number,
+			"min_backoff":            tftypes.String,
+			"max_backoff":            tftypes.String,
+			"retriable_status_codes": tftypes.List{ElementType: tftypes.Number},
+			"retriable_error_codes":  tftypes.List{ElementType: tftypes.String},
+		}
+		overrideTypes := map[string]tftypes.Type{
+			"resource_types": tftypes.List{ElementType: tftypes.String},
+		}
+		for k, v := range settingsTypes {
+			overrideTypes[k] = v
+		}
+		overrideType := tftypes.List{ElementType: tftypes.Object{AttributeTypes: overrideTypes}}
+		retryTypes := map[string]tftypes.Type{
+			"override": overrideType,
+		}
+		for k, v := range settingsTypes {
+			retryTypes[k] = v
+		}
+		retryType := tftypes.Object{AttributeTypes: retryTypes}
+		rawConfigTypeMap["retry"] = tftypes.List{ElementType: retryType}
+		rawConfigValueMap["retry"] = tftypes.NewValue(tftypes.List{ElementType: retryType}, []tftypes.Value{
+			tftypes.NewValue(retryType, map[string]tftypes.Value{
+				"max_attempts":           tftypes.NewValue(tftypes.Number, pf.retry.maxAttempts),
+				"min_backoff":            tftypes.NewValue(tftypes.String, pf.retry.minBackoff),
+				"max_backoff":            tftypes.NewValue(tftypes.String, nil),
+				"retriable_status_codes": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+				"retriable_error_codes":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"override":               tftypes.NewValue(overrideType, nil),
+			}),
+		})
+	}
 
 	rawConfigType := tftypes.Object{
 		AttributeTypes: rawConfigTypeMap,
@@ -155,6 +214,10 @@ func (pf providerFixture) applyAssertions(c *common.DatabricksClient, t *testing
 	if pf.assertScopes != nil {
 		assert.Equal(t, pf.assertScopes, c.Config.Scopes)
 	}
+	if pf.assertRetry {
+		assert.NotNil(t, c.Config.HTTPTransport, "retry policy should be applied")
+	}
+	assert.Equal(t, pf.assertReadOnly, c.IsReadOnly())
 	return c
 }
 
@@ -24,11 +24,13 @@ import (
 	"github.com/databricks/terraform-provider-databricks/access"
 	"github.com/databricks/terraform-provider-databricks/apps"
 	"github.com/databricks/terraform-provider-databricks/aws"
+	"github.com/databricks/terraform-provider-databricks/azure"
 	"github.com/databricks/terraform-provider-databricks/catalog"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
 	"github.com/databricks/terraform-provider-databricks/dashboards"
 	"github.com/databricks/terraform-provider-databricks/finops"
+	"github.com/databricks/terraform-provider-databricks/gcp"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/client"
 	providercommon "github.com/databricks/terraform-provider-databricks/internal/providers/common"
 	"github.com/databricks/terraform-provider-databricks/internal/providers/pluginfw"
@@ -111,60 +113,66 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 	}
 
 	dataSourceMap := map[string]*schema.Resource{ // must be in alphabetical order
-		"databricks_aws_crossaccount_policy":              aws.DataAwsCrossaccountPolicy().ToResource(),
-		"databricks_aws_assume_role_policy":               aws.DataAwsAssumeRolePolicy().ToResource(),
-		"databricks_aws_bucket_policy":                    aws.DataAwsBucketPolicy().ToResource(),
-		"databricks_aws_unity_catalog_assume_role_policy": aws.DataAwsUnityCatalogAssumeRolePolicy().ToResource(),
-		"databricks_aws_unity_catalog_policy":             aws.DataAwsUnityCatalogPolicy().ToResource(),
-		"databricks_cluster":                              clusters.DataSourceCluster().ToResource(),
-		"databricks_clusters":                             clusters.DataSourceClusters().ToResource(),
-		"databricks_cluster_policy":                       policies.DataSourceClusterPolicy().ToResource(),
-		"databricks_catalog":                              catalog.DataSourceCatalog().ToResource(),
-		"databricks_catalogs":                             catalog.DataSourceCatalogs().ToResource(),
-		"databricks_current_config":                       mws.DataSourceCurrentConfiguration().ToResource(),
-		"databricks_current_metastore":                    catalog.DataSourceCurrentMetastore().ToResource(),
-		"databricks_current_user":                         scim.DataSourceCurrentUser().ToResource(),
-		"databricks_dbfs_file":                            storage.DataSourceDbfsFile().ToResource(),
-		"databricks_dbfs_file_paths":                      storage.DataSourceDbfsFilePaths().ToResource(),
-		"databricks_directory":                            workspace.DataSourceDirectory().ToResource(),
-		"databricks_external_location":                    catalog.DataSourceExternalLocation().ToResource(),
-		"databricks_external_locations":                   catalog.DataSourceExternalLocations().ToResource(),
-		"databricks_group":                                scim.DataSourceGroup().ToResource(),
-		"databricks_instance_pool":                        pools.DataSourceInstancePool().ToResource(),
-		"databricks_instance_profiles":                    aws.DataSourceInstanceProfiles().ToResource(),
-		"databricks_jobs":                                 jobs.DataSourceJobs().ToResource(),
-		"databricks_job":                                  jobs.DataSourceJob().ToResource(),
-		"databricks_metastore":                            catalog.DataSourceMetastore().ToResource(),
-		"databricks_metastores":                           catalog.DataSourceMetastores().ToResource(),
-		"databricks_mlflow_experiment":                    mlflow.DataSourceExperiment().ToResource(),
-		"databricks_mlflow_model":                         mlflow.DataSourceModel().ToResource(),
-		"databricks_mlflow_models":                        mlflow.DataSourceModels().ToResource(),
-		"databricks_mws_credentials":                      mws.DataSourceMwsCredentials().ToResource(),
-		"databricks_mws_network_connectivity_config":      mws.DataSourceMwsNetworkConnectivityConfig().ToResource(),
-		"databricks_mws_network_connectivity_configs":     mws.DataSourceMwsNetworkConnectivityConfigs().ToResource(),
-		"databricks_mws_workspaces":                       mws.DataSourceMwsWorkspaces().ToResource(),
-		"databricks_node_type":                            clusters.DataSourceNodeType().ToResource(),
-		"databricks_notebook":                             workspace.DataSourceNotebook().ToResource(),
-		"databricks_notebook_paths":                       workspace.DataSourceNotebookPaths().ToResource(),
-		"databricks_pipelines":                            pipelines.DataSourcePipelines().ToResource(),
-		"databricks_schema":                               catalog.DataSourceSchema().ToResource(),
-		"databricks_schemas":                              catalog.DataSourceSchemas().ToResource(),
-		"databricks_service_principal":                    scim.DataSourceServicePrincipal().ToResource(),
-		"databricks_service_principals":                   scim.DataSourceServicePrincipals().ToResource(),
-		"databricks_share":                                sharing.DataSourceShare().ToResource(),
-		"databricks_shares":                               sharing.DataSourceShares().ToResource(),
-		"databricks_spark_version":                        clusters.DataSourceSparkVersion().ToResource(),
-		"databricks_sql_warehouse":                        sql.DataSourceWarehouse().ToResource(),
-		"databricks_sql_warehouses":                       sql.DataSourceWarehouses().ToResource(),
-		"databricks_storage_credential":                   catalog.DataSourceStorageCredential().ToResource(),
-		"databricks_storage_credentials":                  catalog.DataSourceStorageCredentials().ToResource(),
-		"databricks_table":                                catalog.DataSourceTable().ToResource(),
-		"databricks_tables":                               catalog.DataSourceTables().ToResource(),
-		"databricks_views":                                catalog.DataSourceViews().ToResource(),
-		"databricks_volume":                               catalog.DataSourceVolume().ToResource(),
-		"databricks_volumes":                              catalog.DataSourceVolumes().ToResource(),
-		"databricks_user":                                 scim.DataSourceUser().ToResource(),
-		"databricks_zones":                                clusters.DataSourceClusterZones().ToResource(),
+		"databricks_aws_crossaccount_policy":                     aws.DataAwsCrossaccountPolicy().ToResource(),
+		"databricks_aws_assume_role_policy":                      aws.DataAwsAssumeRolePolicy().ToResource(),
+		"databricks_aws_bucket_policy":                           aws.DataAwsBucketPolicy().ToResource(),
+		"databricks_aws_unity_catalog_assume_role_policy":        aws.DataAwsUnityCatalogAssumeRolePolicy().ToResource(),
+		"databricks_aws_unity_catalog_external_locations_policy": aws.DataAwsUnityCatalogExternalLocationsPolicy().ToResource(),
+		"databricks_aws_unity_catalog_policy":                    aws.DataAwsUnityCatalogPolicy().ToResource(),
+		"databricks_azure_unity_catalog_policy":                  azure.DataAzureUnityCatalogPolicy().ToResource(),
+		"databricks_azure_vnet_injection_policy":                 azure.DataAzureVnetInjectionPolicy().ToResource(),
+		"databricks_cluster":                                     clusters.DataSourceCluster().ToResource(),
+		"databricks_clusters":                                    clusters.DataSourceClusters().ToResource(),
+		"databricks_cluster_policy":                              policies.DataSourceClusterPolicy().ToResource(),
+		"databricks_catalog":                                     catalog.DataSourceCatalog().ToResource(),
+		"databricks_catalogs":                                    catalog.DataSourceCatalogs().ToResource(),
+		"databricks_current_config":                              mws.DataSourceCurrentConfiguration().ToResource(),
+		"databricks_current_metastore":                           catalog.DataSourceCurrentMetastore().ToResource(),
+		"databricks_current_user":                                scim.DataSourceCurrentUser().ToResource(),
+		"databricks_dbfs_file":                                   storage.DataSourceDbfsFile().ToResource(),
+		"databricks_dbfs_file_paths":                             storage.DataSourceDbfsFilePaths().ToResource(),
+		"databricks_directory":                                   workspace.DataSourceDirectory().ToResource(),
+		"databricks_effective_grants":                            catalog.DataSourceEffectiveGrants().ToResource(),
+		"databricks_external_location":                           catalog.DataSourceExternalLocation().ToResource(),
+		"databricks_external_locations":                          catalog.DataSourceExternalLocations().ToResource(),
+		"databricks_gcp_unity_catalog_policy":                    gcp.DataGcpUnityCatalogPolicy().ToResource(),
+		"databricks_gcp_workspace_policy":                        gcp.DataGcpWorkspacePolicy().ToResource(),
+		"databricks_group":                                       scim.DataSourceGroup().ToResource(),
+		"databricks_instance_pool":                               pools.DataSourceInstancePool().ToResource(),
+		"databricks_instance_profiles":                           aws.DataSourceInstanceProfiles().ToResource(),
+		"databricks_jobs":                                        jobs.DataSourceJobs().ToResource(),
+		"databricks_job":                                         jobs.DataSourceJob().ToResource(),
+		"databricks_metastore":                                   catalog.DataSourceMetastore().ToResource(),
+		"databricks_metastores":                                  catalog.DataSourceMetastores().ToResource(),
+		"databricks_mlflow_experiment":                           mlflow.DataSourceExperiment().ToResource(),
+		"databricks_mlflow_model":                                mlflow.DataSourceModel().ToResource(),
+		"databricks_mlflow_models":                               mlflow.DataSourceModels().ToResource(),
+		"databricks_mws_credentials":                             mws.DataSourceMwsCredentials().ToResource(),
+		"databricks_mws_network_connectivity_config":             mws.DataSourceMwsNetworkConnectivityConfig().ToResource(),
+		"databricks_mws_network_connectivity_configs":            mws.DataSourceMwsNetworkConnectivityConfigs().ToResource(),
+		"databricks_mws_workspaces":                              mws.DataSourceMwsWorkspaces().ToResource(),
+		"databricks_node_type":                                   clusters.DataSourceNodeType().ToResource(),
+		"databricks_notebook":                                    workspace.DataSourceNotebook().ToResource(),
+		"databricks_notebook_paths":                              workspace.DataSourceNotebookPaths().ToResource(),
+		"databricks_pipelines":                                   pipelines.DataSourcePipelines().ToResource(),
+		"databricks_schema":                                      catalog.DataSourceSchema().ToResource(),
+		"databricks_schemas":                                     catalog.DataSourceSchemas().ToResource(),
+		"databricks_service_principal":                           scim.DataSourceServicePrincipal().ToResource(),
+		"databricks_service_principals":                          scim.DataSourceServicePrincipals().ToResource(),
+		"databricks_share":                                       sharing.DataSourceShare().ToResource(),
+		"databricks_shares":                                      sharing.DataSourceShares().ToResource(),
+		"databricks_spark_version":                               clusters.DataSourceSparkVersion().ToResource(),
+		"databricks_sql_warehouse":                               sql.DataSourceWarehouse().ToResource(),
+		"databricks_sql_warehouses":                              sql.DataSourceWarehouses().ToResource(),
+		"databricks_storage_credential":                          catalog.DataSourceStorageCredential().ToResource(),
+		"databricks_storage_credentials":                         catalog.DataSourceStorageCredentials().ToResource(),
+		"databricks_table":                                       catalog.DataSourceTable().ToResource(),
+		"databricks_tables":                                      catalog.DataSourceTables().ToResource(),
+		"databricks_views":                                       catalog.DataSourceViews().ToResource(),
+		"databricks_volume":                                      catalog.DataSourceVolume().ToResource(),
+		"databricks_volumes":                                     catalog.DataSourceVolumes().ToResource(),
+		"databricks_user":                                        scim.DataSourceUser().ToResource(),
+		"databricks_zones":                                       clusters.DataSourceClusterZones().ToResource(),
 	}
 
 	resourceMap := map[string]*schema.Resource{ // must be in alphabetical order
@@ -177,6 +185,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_azure_blob_mount":                     storage.ResourceAzureBlobMount().ToResource(),
 		"databricks_budget":                               finops.ResourceBudget().ToResource(),
 		"databricks_catalog":                              catalog.ResourceCatalog().ToResource(),
+		"databricks_catalog_tree_grants":                  catalog.ResourceCatalogTreeGrants().ToResource(),
 		"databricks_catalog_workspace_binding":            catalog.ResourceCatalogWorkspaceBinding().ToResource(),
 		"databricks_credential":                           catalog.ResourceCredential().ToResource(),
 		"databricks_custom_app_integration":               apps.ResourceCustomAppIntegration().ToResource(),
@@ -196,6 +205,7 @@ func DatabricksProvider(opts ...SdkV2ProviderOption) *schema.Provider {
 		"databricks_group":                                scim.ResourceGroup().ToResource(),
 		"databricks_group_instance_profile":               aws.ResourceGroupInstanceProfile().ToResource(),
 		"databricks_group_member":                         scim.ResourceGroupMember().ToResource(),
+		"databricks_group_members":                        scim.ResourceGroupMembers().ToResource(),
 		"databricks_group_role":                           scim.ResourceGroupRole().ToResource(),
 		"databricks_instance_pool":                        pools.ResourceInstancePool().ToResource(),
 		"databricks_instance_profile":                     aws.ResourceInstanceProfile().ToResource(),
@@ -336,9 +346,115 @@ func providerSchema() map[string]*schema.Schema {
 			}
 		}
 	}
+	ps["audit_log_file"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	ps["read_only"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	ps["read_cache"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	ps["retry"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: retrySettingsSchema(map[string]*schema.Schema{
+				"override": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: retrySettingsSchema(map[string]*schema.Schema{
+							"resource_types": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+					},
+				},
+			}),
+		},
+	}
 	return ps
 }
 
+// retrySettingsSchema adds fields of common.RetrySettings to the given schema of the retry block
+func retrySettingsSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["max_attempts"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+	s["min_backoff"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["max_backoff"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["retriable_status_codes"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeInt},
+	}
+	s["retriable_error_codes"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return s
+}
+
+func retrySettingsFromMap(m map[string]any) common.RetrySettings {
+	settings := common.RetrySettings{
+		MaxAttempts: m["max_attempts"].(int),
+		MinBackoff:  m["min_backoff"].(string),
+		MaxBackoff:  m["max_backoff"].(string),
+	}
+	if codes, ok := m["retriable_status_codes"].([]any); ok && len(codes) > 0 {
+		for _, v := range codes {
+			settings.RetriableStatusCodes = append(settings.RetriableStatusCodes, v.(int))
+		}
+	}
+	if codes, ok := m["retriable_error_codes"].([]any); ok && len(codes) > 0 {
+		for _, v := range codes {
+			settings.RetriableErrorCodes = append(settings.RetriableErrorCodes, v.(string))
+		}
+	}
+	return settings
+}
+
+// retryPolicyFromResourceData returns the retry policy from the retry block of the provider configuration,
+// or nil if the block isn't specified
+func retryPolicyFromResourceData(d *schema.ResourceData) (*common.RetryPolicy, error) {
+	blocks := d.Get("retry").([]any)
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	if len(blocks) > 1 {
+		return nil, fmt.Errorf("only one retry block is allowed, got %d", len(blocks))
+	}
+	m, ok := blocks[0].(map[string]any)
+	if !ok {
+		// empty retry block enables retries with default settings
+		return common.NewRetryPolicy(common.RetrySettings{}, nil)
+	}
+	var overrides []common.RetryOverride
+	for _, o := range m["override"].([]any) {
+		om := o.(map[string]any)
+		override := common.RetryOverride{RetrySettings: retrySettingsFromMap(om)}
+		for _, v := range om["resource_types"].([]any) {
+			override.ResourceTypes = append(override.ResourceTypes, v.(string))
+		}
+		overrides = append(overrides, override)
+	}
+	return common.NewRetryPolicy(retrySettingsFromMap(m), overrides)
+}
+
 func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData, configCustomizer func(*config.Config) error) (any, diag.Diagnostics) {
 	cfg := &config.Config{}
 	attrsUsed := []string{}
@@ -369,10 +485,29 @@ func ConfigureDatabricksClient(ctx context.Context, d *schema.ResourceData, conf
 	} else {
 		tflog.Info(ctx, "(sdkv2) No attributes specified in provider configuration")
 	}
+	err := client.ApplyAuditLog(cfg, d.Get("audit_log_file").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	retryPolicy, err := retryPolicyFromResourceData(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if retryPolicy != nil {
+		client.ApplyRetryPolicy(cfg, retryPolicy)
+	}
+	readOnly := d.Get("read_only").(bool)
+	if readOnly {
+		client.ApplyReadOnly(cfg)
+	}
+	if d.Get("read_cache").(bool) {
+		client.ApplyReadCache(cfg)
+	}
 	databricksClient, err := client.PrepareDatabricksClient(ctx, cfg, configCustomizer)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
+	databricksClient.SetReadOnly(readOnly)
 	return databricksClient, nil
 }
 
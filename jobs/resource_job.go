@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,6 +24,7 @@ import (
 	"github.com/databricks/databricks-sdk-go/apierr"
 	"github.com/databricks/terraform-provider-databricks/clusters"
 	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/policies"
 	"github.com/databricks/terraform-provider-databricks/repos"
 )
 
@@ -1065,6 +1069,66 @@ func prepareJobSettingsForUpdate(d *schema.ResourceData, js JobSettings) {
 
 var jobsGoSdkSchema = common.StructToSchema(JobSettingsResource{}, nil)
 
+// validateJobClusterPolicies checks all new clusters of the job against the rules of their cluster policies, so
+// violations are reported during the plan instead of failing in the middle of apply
+func validateJobClusterPolicies(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient,
+	jsr JobSettingsResource) error {
+	if d.Id() != "" && !d.HasChanges(slices.Collect(maps.Keys(jobsGoSdkSchema))...) {
+		return nil
+	}
+	clusterSpecs := map[string]*compute.ClusterSpec{}
+	if jsr.NewCluster != nil {
+		clusterSpecs["new_cluster"] = jsr.NewCluster
+	}
+	for i := range jsr.JobClusters {
+		clusterSpecs[fmt.Sprintf("job_cluster[%s].new_cluster", jsr.JobClusters[i].JobClusterKey)] =
+			&jsr.JobClusters[i].NewCluster
+	}
+	for _, task := range jsr.Tasks {
+		if task.NewCluster != nil {
+			clusterSpecs[fmt.Sprintf("task[%s].new_cluster", task.TaskKey)] = task.NewCluster
+		}
+		if task.ForEachTask != nil && task.ForEachTask.Task.NewCluster != nil {
+			clusterSpecs[fmt.Sprintf("task[%s].for_each_task.task.new_cluster", task.TaskKey)] =
+				task.ForEachTask.Task.NewCluster
+		}
+	}
+	var validator *policies.ClusterSpecValidator
+	locations := slices.Sorted(maps.Keys(clusterSpecs))
+	errs := []error{}
+	for _, location := range locations {
+		spec := clusterSpecs[location]
+		if spec.PolicyId == "" {
+			continue
+		}
+		if validator == nil {
+			w, err := c.WorkspaceClientUnifiedProviderForDiff(ctx, d)
+			if err != nil {
+				return err
+			}
+			validator = policies.NewClusterSpecValidator(w)
+		}
+		if err := validator.Validate(ctx, spec.PolicyId, policies.ClusterTypeJob, spec, location); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ListJobs lists the jobs of the workspace, skipping the jobs that are deployed by Databricks Asset Bundles
+// and locked for editing, as these are managed by the bundle.
+func ListJobs(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[jobs.BaseJob, error] {
+	it := w.Jobs.List(ctx, jobs.ListJobsRequest{ExpandTasks: false, Limit: 100})
+	return common.ListingSeq(ctx, it, func(job jobs.BaseJob) bool {
+		if job.Settings != nil && job.Settings.Deployment != nil && job.Settings.Deployment.Kind == "BUNDLE" &&
+			job.Settings.EditMode == "UI_LOCKED" {
+			log.Printf("[INFO] Skipping job '%s' because it's deployed by DABs", job.Settings.Name)
+			return false
+		}
+		return true
+	})
+}
+
 func ResourceJob() common.Resource {
 	getReadCtx := func(ctx context.Context, d *schema.ResourceData) context.Context {
 		var jsr JobSettingsResource
@@ -1097,6 +1161,9 @@ func ResourceJob() common.Resource {
 					return fmt.Errorf("`control_run_state` must be specified only with `max_concurrent_runs = 1`")
 				}
 			}
+			if err := validateJobClusterPolicies(ctx, d, c, jsr); err != nil {
+				return err
+			}
 			return common.NamespaceCustomizeDiff(ctx, d, c)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -1219,6 +1286,7 @@ func ResourceJob() common.Resource {
 			}
 			return w.Jobs.DeleteByJobId(ctx, jobID)
 		},
+		WithIdentity: true,
 	}
 }
 
@@ -3639,3 +3639,56 @@ func TestJobResource_SparkConfDiffSuppress(t *testing.T) {
 	assert.True(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "0", nil))
 	assert.False(t, scs.DiffSuppressFunc("new_cluster.0.spark_conf.%", "1", "1", nil))
 }
+
+func TestResourceJobCreate_JobClustersPolicyViolations(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: compute.Policy{
+					PolicyId: "abc",
+					Name:     "Interactive only",
+					Definition: `{"cluster_type": {"type": "fixed", "value": "all-purpose"},
+					"spark_version": {"type": "regex", "pattern": "15\\..*"}}`,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "JobClustered"
+		job_cluster {
+			job_cluster_key = "j"
+			new_cluster {
+				num_workers = 2
+				spark_version = "14.3.x-scala2.12"
+				node_type_id = "Standard_DS3_v2"
+				policy_id = "abc"
+			}
+		}
+		task {
+			task_key = "a"
+			new_cluster {
+				num_workers = 1
+				spark_version = "15.4.x-scala2.12"
+				node_type_id = "Standard_DS3_v2"
+				policy_id = "abc"
+			}
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}
+		task {
+			task_key = "b"
+			job_cluster_key = "j"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "cluster doesn't satisfy the policy 'Interactive only' (abc):\n"+
+		"job_cluster[j].new_cluster.cluster_type: value \"job\" must be equal to the fixed value \"all-purpose\"\n"+
+		"job_cluster[j].new_cluster.spark_version: value \"14.3.x-scala2.12\" doesn't match the pattern \"15\\\\..*\"\n"+
+		"cluster doesn't satisfy the policy 'Interactive only' (abc):\n"+
+		"task[a].new_cluster.cluster_type: value \"job\" must be equal to the fixed value \"all-purpose\"")
+}
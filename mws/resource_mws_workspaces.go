@@ -605,8 +605,9 @@ func ResourceMwsWorkspaces() common.Resource {
 			return workspaceSchema
 		})
 	return common.Resource{
-		Schema:        workspaceSchema,
-		SchemaVersion: 3,
+		Schema:             workspaceSchema,
+		SchemaVersion:      3,
+		DeletionProtection: true,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Version: 2,
@@ -303,6 +303,36 @@ func getResourcePermissionsFromId(id string) (resourcePermissions, error) {
 	return resourcePermissions{}, fmt.Errorf("resource type for %s not found", id)
 }
 
+// PermissionsObject describes the object referenced by the ID of a databricks_permissions resource.
+type PermissionsObject struct {
+	// The attribute name that users configure with the ID of the object, e.g. "cluster_id".
+	Field string
+	// The object type in the Permissions API, e.g. "cluster".
+	ObjectType string
+	// The object type in the ID of the resource, e.g. "clusters".
+	RequestObjectType string
+	// The ID of the object, e.g. the cluster ID.
+	ObjectId string
+}
+
+// ParsePermissionsObjectId parses the `/<type>/<id>` ID of a databricks_permissions resource.
+func ParsePermissionsObjectId(id string) (PermissionsObject, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 3 || idParts[0] != "" || idParts[len(idParts)-1] == "" {
+		return PermissionsObject{}, fmt.Errorf("invalid permissions ID %s, expected /<type>/<id>", id)
+	}
+	mapping, err := getResourcePermissionsFromId(id)
+	if err != nil {
+		return PermissionsObject{}, err
+	}
+	return PermissionsObject{
+		Field:             mapping.field,
+		ObjectType:        mapping.objectType,
+		RequestObjectType: mapping.requestObjectType,
+		ObjectId:          idParts[len(idParts)-1],
+	}, nil
+}
+
 // getResourcePermissionsFromState returns the resourcePermissions for the given state.
 func getResourcePermissionsFromState(d interface{ GetOk(string) (any, bool) }) (resourcePermissions, string, error) {
 	allPermissions := allResourcePermissions()
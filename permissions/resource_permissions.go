@@ -133,7 +133,7 @@ func (a PermissionsAPI) readRaw(objectID string, mapping resourcePermissions) (*
 	// TODO: This a temporary measure to implement retry on 504 until this is
 	// supported natively in the Go SDK.
 	permissions, err := common.RetryOn504(a.context, func(ctx context.Context) (*iam.ObjectPermissions, error) {
-		return w.Permissions.Get(a.context, iam.GetPermissionRequest{
+		return w.Permissions.Get(common.WithReadCache(a.context), iam.GetPermissionRequest{
 			RequestObjectId:   id,
 			RequestObjectType: mapping.requestObjectType,
 		})
@@ -1918,3 +1918,24 @@ func TestAccessControlHashFunction(t *testing.T) {
 	hash5 := acSchema.Set(elem5)
 	assert.Equal(t, hash4, hash5, "Service principal elements with and without empty fields should have the same hash")
 }
+
+func TestParsePermissionsObjectId(t *testing.T) {
+	object, err := ParsePermissionsObjectId("/clusters/abc")
+	require.NoError(t, err)
+	assert.Equal(t, PermissionsObject{
+		Field:             "cluster_id",
+		ObjectType:        "cluster",
+		RequestObjectType: "clusters",
+		ObjectId:          "abc",
+	}, object)
+
+	object, err = ParsePermissionsObjectId("/sql/warehouses/abc")
+	require.NoError(t, err)
+	assert.Equal(t, "sql_endpoint_id", object.Field)
+	assert.Equal(t, "abc", object.ObjectId)
+
+	for _, id := range []string{"", "abc", "/clusters", "/clusters/", "clusters/abc", "/unknown/abc"} {
+		_, err = ParsePermissionsObjectId(id)
+		assert.Error(t, err, id)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log"
 	"regexp"
 	"time"
@@ -284,6 +285,13 @@ func (Pipeline) CustomizeSchema(s *common.CustomizableSchema) *common.Customizab
 
 var pipelineSchema = common.StructToSchema(Pipeline{}, nil)
 
+// ListPipelines lists the pipelines of the workspace.
+func ListPipelines(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[pipelines.PipelineStateInfo, error] {
+	return common.ListingSeq(ctx, w.Pipelines.ListPipelines(ctx, pipelines.ListPipelinesRequest{
+		MaxResults: 100,
+	}), nil)
+}
+
 func ResourcePipeline() common.Resource {
 	return common.Resource{
 		Schema: pipelineSchema,
@@ -361,5 +369,6 @@ func ResourcePipeline() common.Resource {
 			}
 			return nil
 		},
+		WithIdentity: true,
 	}
 }
@@ -0,0 +1,269 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+const (
+	// ClusterTypeAllPurpose is the value of the `cluster_type` virtual attribute for interactive clusters
+	ClusterTypeAllPurpose = "all-purpose"
+	// ClusterTypeJob is the value of the `cluster_type` virtual attribute for job clusters
+	ClusterTypeJob = "job"
+)
+
+// virtual attributes that can't be evaluated without the backend
+var unsupportedPolicyAttributes = map[string]struct{}{
+	"dbus_per_hour": {},
+}
+
+// PolicyRule is a single rule of the cluster policy definition. See
+// https://docs.databricks.com/en/admin/clusters/policy-definition.html
+type PolicyRule struct {
+	Type         string   `json:"type"`
+	Value        any      `json:"value,omitempty"`
+	Values       []any    `json:"values,omitempty"`
+	MinValue     *float64 `json:"minValue,omitempty"`
+	MaxValue     *float64 `json:"maxValue,omitempty"`
+	Pattern      string   `json:"pattern,omitempty"`
+	DefaultValue any      `json:"defaultValue,omitempty"`
+	IsOptional   bool     `json:"isOptional,omitempty"`
+	Hidden       bool     `json:"hidden,omitempty"`
+}
+
+// PolicyDefinition is a parsed cluster policy definition, where keys are paths of the attributes
+type PolicyDefinition map[string]PolicyRule
+
+// ParsePolicyDefinition parses JSON definition of the cluster policy
+func ParsePolicyDefinition(definition string) (PolicyDefinition, error) {
+	var policy PolicyDefinition
+	if err := json.Unmarshal([]byte(definition), &policy); err != nil {
+		return nil, fmt.Errorf("can't parse policy definition: %w", err)
+	}
+	return policy, nil
+}
+
+// PolicyViolation describes a cluster attribute that doesn't satisfy a policy rule
+type PolicyViolation struct {
+	Path    string
+	Message string
+}
+
+func (v PolicyViolation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// FlattenClusterSpec converts a cluster specification (i.e., compute.ClusterSpec) into a map, where keys are
+// the paths to attributes in the same format as used in the policy definitions, like, `autoscale.max_workers`,
+// `spark_conf.spark.databricks.io.cache.enabled` or `init_scripts.0.workspace.destination`
+func FlattenClusterSpec(spec any) (map[string]any, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	result := map[string]any{}
+	flattenValue("", m, result)
+	return result, nil
+}
+
+func flattenValue(prefix string, v any, result map[string]any) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			flattenValue(prefix+k+".", e, result)
+		}
+	case []any:
+		for i, e := range t {
+			flattenValue(prefix+strconv.Itoa(i)+".", e, result)
+		}
+	default:
+		result[strings.TrimSuffix(prefix, ".")] = v
+	}
+}
+
+func formatPolicyValue(v any) string {
+	switch t := v.(type) {
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case string:
+		return t
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+// pathsForRule returns paths from cluster attributes matching the policy path, that could include `*`
+// as a wildcard for the index in arrays, like, `init_scripts.*.workspace.destination`
+func pathsForRule(policyPath string, attributes map[string]any) []string {
+	if !strings.Contains(policyPath, "*") {
+		if _, ok := attributes[policyPath]; ok {
+			return []string{policyPath}
+		}
+		return nil
+	}
+	re := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(policyPath), `\*`, `[^.]+`) + "$")
+	paths := []string{}
+	for k := range attributes {
+		if re.MatchString(k) {
+			paths = append(paths, k)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func inValues(value string, values []any) bool {
+	for _, v := range values {
+		if formatPolicyValue(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func formatValues(values []any) string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, formatPolicyValue(v))
+	}
+	return strings.Join(result, ", ")
+}
+
+// check evaluates a rule against a single value. Returns an empty string if value satisfies the rule
+func (r PolicyRule) check(value any) string {
+	str := formatPolicyValue(value)
+	switch r.Type {
+	case "fixed":
+		if expected := formatPolicyValue(r.Value); str != expected {
+			return fmt.Sprintf("value %q must be equal to the fixed value %q", str, expected)
+		}
+	case "forbidden":
+		return "attribute is forbidden"
+	case "allowlist":
+		if !inValues(str, r.Values) {
+			return fmt.Sprintf("value %q isn't in the allowed list: %s", str, formatValues(r.Values))
+		}
+	case "blocklist":
+		if inValues(str, r.Values) {
+			return fmt.Sprintf("value %q is in the blocked list: %s", str, formatValues(r.Values))
+		}
+	case "range":
+		num, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Sprintf("value %q isn't a number", str)
+		}
+		if r.MinValue != nil && num < *r.MinValue {
+			return fmt.Sprintf("value %s is less than the minimum %s", str, formatPolicyValue(*r.MinValue))
+		}
+		if r.MaxValue != nil && num > *r.MaxValue {
+			return fmt.Sprintf("value %s is greater than the maximum %s", str, formatPolicyValue(*r.MaxValue))
+		}
+	case "regex":
+		re, err := regexp.Compile("^(?:" + r.Pattern + ")$")
+		if err != nil {
+			return fmt.Sprintf("invalid pattern %q in the policy: %s", r.Pattern, err)
+		}
+		if !re.MatchString(str) {
+			return fmt.Sprintf("value %q doesn't match the pattern %q", str, r.Pattern)
+		}
+	}
+	return ""
+}
+
+// Evaluate checks flattened cluster attributes (see FlattenClusterSpec) against the policy rules. Only
+// attributes that are specified are checked, because missing values could be filled from the policy defaults.
+func (p PolicyDefinition) Evaluate(attributes map[string]any) []PolicyViolation {
+	violations := []PolicyViolation{}
+	for policyPath, rule := range p {
+		if _, ok := unsupportedPolicyAttributes[policyPath]; ok {
+			continue
+		}
+		for _, path := range pathsForRule(policyPath, attributes) {
+			if msg := rule.check(attributes[path]); msg != "" {
+				violations = append(violations, PolicyViolation{Path: path, Message: msg})
+			}
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations
+}
+
+// EvaluateClusterSpec flattens cluster specification and checks it against the policy. The `cluster_type`
+// virtual attribute is set from the given value
+func (p PolicyDefinition) EvaluateClusterSpec(spec any, clusterType string) ([]PolicyViolation, error) {
+	attributes, err := FlattenClusterSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if clusterType != "" {
+		attributes["cluster_type"] = clusterType
+	}
+	return p.Evaluate(attributes), nil
+}
+
+// ClusterSpecValidator checks cluster specifications against their cluster policies. Each policy is fetched
+// only once, so the same validator could be used for all clusters of a job.
+type ClusterSpecValidator struct {
+	w        *databricks.WorkspaceClient
+	policies map[string]*compute.Policy
+}
+
+// NewClusterSpecValidator creates a new validator that uses a given workspace client to fetch policies
+func NewClusterSpecValidator(w *databricks.WorkspaceClient) *ClusterSpecValidator {
+	return &ClusterSpecValidator{w: w, policies: map[string]*compute.Policy{}}
+}
+
+// Validate checks the cluster specification against the policy with a given ID. Returns an error that lists all
+// violations prefixed with the given location of the cluster specification. If the policy can't be fetched, the
+// check is skipped, because the cluster is still validated by the backend during apply.
+func (v *ClusterSpecValidator) Validate(ctx context.Context, policyId, clusterType string, spec any,
+	location string) error {
+	policy, ok := v.policies[policyId]
+	if !ok {
+		var err error
+		policy, err = v.w.ClusterPolicies.Get(ctx, compute.GetClusterPolicyRequest{PolicyId: policyId})
+		if err != nil {
+			log.Printf("[WARN] can't get cluster policy %s, skipping validation: %v", policyId, err)
+			policy = nil
+		}
+		v.policies[policyId] = policy
+	}
+	if policy == nil || policy.Definition == "" {
+		return nil
+	}
+	definition, err := ParsePolicyDefinition(policy.Definition)
+	if err != nil {
+		return err
+	}
+	violations, err := definition.EvaluateClusterSpec(spec, clusterType)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(violations))
+	for _, violation := range violations {
+		if location != "" {
+			violation.Path = location + "." + violation.Path
+		}
+		errs = append(errs, violation)
+	}
+	return fmt.Errorf("cluster doesn't satisfy the policy '%s' (%s):\n%w", policy.Name, policyId, errors.Join(errs...))
+}
@@ -0,0 +1,163 @@
+package policies
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestPolicy(t *testing.T, name string) PolicyDefinition {
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	policy, err := ParsePolicyDefinition(string(data))
+	require.NoError(t, err)
+	return policy
+}
+
+func TestParsePolicyDefinition(t *testing.T) {
+	policy := readTestPolicy(t, "policy02.json")
+	assert.Equal(t, "range", policy["autoscale.max_workers"].Type)
+	assert.Equal(t, 1.0, *policy["autoscale.max_workers"].MinValue)
+	assert.Equal(t, 10.0, *policy["autoscale.max_workers"].MaxValue)
+	assert.Nil(t, policy["autotermination_minutes"].MinValue)
+	assert.True(t, policy["instance_pool_id"].Hidden)
+
+	_, err := ParsePolicyDefinition("[]")
+	assert.ErrorContains(t, err, "can't parse policy definition")
+}
+
+func TestEvaluateFixedRules(t *testing.T) {
+	policy := readTestPolicy(t, "policy01.json")
+	violations, err := policy.EvaluateClusterSpec(compute.ClusterSpec{
+		SparkVersion: "15.4.x-scala2.12",
+		SparkConf: map[string]string{
+			"spark.databricks.delta.preview.enabled":                 "true",
+			"spark.hadoop.javax.jdo.option.ConnectionDriverName":     "com.microsoft.sqlserver.jdbc.SQLServerDriver",
+			"spark.hadoop.javax.jdo.option.ConnectionUserName":       "other-user",
+			"spark.hadoop.javax.jdo.option.ConnectionURL":            "jdbc:sqlserver://<jdbc-url>",
+			"spark.databricks.io.cache.enabled":                      "true",
+			"spark.hadoop.javax.jdo.option.ConnectionPasswordUnused": "x",
+		},
+	}, ClusterTypeAllPurpose)
+	require.NoError(t, err)
+	assert.Equal(t, []PolicyViolation{
+		{
+			Path:    "spark_conf.spark.hadoop.javax.jdo.option.ConnectionUserName",
+			Message: `value "other-user" must be equal to the fixed value "<metastore-user>"`,
+		},
+	}, violations)
+}
+
+func TestEvaluateAllRuleTypes(t *testing.T) {
+	policy := readTestPolicy(t, "policy02.json")
+	violations, err := policy.EvaluateClusterSpec(compute.ClusterSpec{
+		SparkVersion:           "12.2.x-scala2.12",
+		NodeTypeId:             "m5.xlarge",
+		DriverNodeTypeId:       "i3.16xlarge",
+		InstancePoolId:         "pool",
+		AutoterminationMinutes: 240,
+		Autoscale: &compute.AutoScale{
+			MinWorkers: 1,
+			MaxWorkers: 20,
+		},
+		CustomTags: map[string]string{
+			"team": "ml",
+		},
+		InitScripts: []compute.InitScriptInfo{
+			{Workspace: &compute.WorkspaceStorageInfo{Destination: "/Shared/init-scripts/a.sh"}},
+			{Workspace: &compute.WorkspaceStorageInfo{Destination: "/Users/user@domain.com/b.sh"}},
+		},
+	}, ClusterTypeJob)
+	require.NoError(t, err)
+	assert.Equal(t, []PolicyViolation{
+		{Path: "autoscale.max_workers", Message: "value 20 is greater than the maximum 10"},
+		{Path: "autotermination_minutes", Message: "value 240 is greater than the maximum 120"},
+		{Path: "cluster_type", Message: `value "job" must be equal to the fixed value "all-purpose"`},
+		{Path: "driver_node_type_id", Message: `value "i3.16xlarge" is in the blocked list: i3.16xlarge`},
+		{Path: "init_scripts.1.workspace.destination",
+			Message: `value "/Users/user@domain.com/b.sh" doesn't match the pattern "/Shared/init-scripts/.*"`},
+		{Path: "instance_pool_id", Message: "attribute is forbidden"},
+		{Path: "node_type_id", Message: `value "m5.xlarge" isn't in the allowed list: i3.xlarge, i3.2xlarge`},
+		{Path: "spark_version", Message: `value "12.2.x-scala2.12" doesn't match the pattern "1[3-5]\\.[0-9]+\\.x-scala.*"`},
+	}, violations)
+}
+
+func TestEvaluateCompliantCluster(t *testing.T) {
+	policy := readTestPolicy(t, "policy02.json")
+	violations, err := policy.EvaluateClusterSpec(compute.ClusterSpec{
+		SparkVersion:           "15.4.x-scala2.12",
+		NodeTypeId:             "i3.xlarge",
+		AutoterminationMinutes: 60,
+		Autoscale: &compute.AutoScale{
+			MinWorkers: 1,
+			MaxWorkers: 10,
+		},
+		InitScripts: []compute.InitScriptInfo{
+			{Workspace: &compute.WorkspaceStorageInfo{Destination: "/Shared/init-scripts/a.sh"}},
+		},
+	}, ClusterTypeAllPurpose)
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func TestEvaluateRangeWithNonNumericValue(t *testing.T) {
+	policy := PolicyDefinition{
+		"spark_conf.spark.executor.cores": {Type: "range", MinValue: new(float64)},
+		"spark_conf.spark.foo":            {Type: "regex", Pattern: "("},
+	}
+	violations := policy.Evaluate(map[string]any{
+		"spark_conf.spark.executor.cores": "many",
+		"spark_conf.spark.foo":            "bar",
+	})
+	assert.Equal(t, []PolicyViolation{
+		{Path: "spark_conf.spark.executor.cores", Message: `value "many" isn't a number`},
+		{Path: "spark_conf.spark.foo",
+			Message: "invalid pattern \"(\" in the policy: error parsing regexp: missing closing ): `^(?:()$`"},
+	}, violations)
+}
+
+func TestClusterSpecValidator(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+			Response: compute.Policy{
+				PolicyId:   "abc",
+				Name:       "Small clusters",
+				Definition: `{"autoscale.max_workers": {"type": "range", "maxValue": 10}}`,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/policies/clusters/get?policy_id=def",
+			Status:   404,
+			Response: apierr.APIError{
+				ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+				Message:   "Policy def does not exist",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		w, err := client.WorkspaceClient()
+		require.NoError(t, err)
+		validator := NewClusterSpecValidator(w)
+		spec := compute.ClusterSpec{
+			Autoscale: &compute.AutoScale{MinWorkers: 1, MaxWorkers: 20},
+		}
+		err = validator.Validate(ctx, "abc", ClusterTypeJob, spec, "job_cluster[a].new_cluster")
+		assert.EqualError(t, err, "cluster doesn't satisfy the policy 'Small clusters' (abc):\n"+
+			"job_cluster[a].new_cluster.autoscale.max_workers: value 20 is greater than the maximum 10")
+		// policy is fetched only once
+		spec.Autoscale.MaxWorkers = 5
+		assert.NoError(t, validator.Validate(ctx, "abc", ClusterTypeJob, spec, ""))
+		// validation is skipped if policy can't be fetched
+		assert.NoError(t, validator.Validate(ctx, "def", ClusterTypeJob, spec, ""))
+		assert.NoError(t, validator.Validate(ctx, "def", ClusterTypeJob, spec, ""))
+	})
+}
@@ -105,6 +105,10 @@ type ResourceFixture struct {
 	// level so any command execution API requests are not sent to the server.
 	CommandMock common.CommandMock
 
+	// Mocks the execution of SQL statements on SQL warehouses. This mock is loaded at
+	// the client level so no Statement Execution API requests are sent to the server.
+	StatementMock common.StatementMock
+
 	// Set one of them to true to test the corresponding CRUD function for the
 	// terraform resource. Or set ExpectedDiff to skip execution and only test
 	// that the diff is expected.
@@ -267,6 +271,9 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.CommandMock != nil {
 		client.WithCommandMock(f.CommandMock)
 	}
+	if f.StatementMock != nil {
+		client.WithStatementMock(f.StatementMock)
+	}
 	if f.Azure {
 		config.AzureResourceID = "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/workspaces/c"
 	}
@@ -37,7 +37,7 @@ func (a GroupsAPI) Create(scimGroupRequest Group) (group Group, err error) {
 func (a GroupsAPI) Read(groupID, attributes string) (group Group, err error) {
 	key := fmt.Sprintf(
 		"/preview/scim/v2/Groups/%v?attributes=%s", groupID, attributes)
-	err = a.client.Scim(a.context, http.MethodGet, key, nil, &group, a.ApiLevel)
+	err = a.client.Scim(common.WithReadCache(a.context), http.MethodGet, key, nil, &group, a.ApiLevel)
 	return
 }
 
@@ -51,7 +51,7 @@ func (a GroupsAPI) Filter(filter string, attributes string) (GroupList, error) {
 	if attributes != "" {
 		req["attributes"] = attributes
 	}
-	err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", req, &groups, a.ApiLevel)
+	err := a.client.Scim(common.WithReadCache(a.context), http.MethodGet, "/preview/scim/v2/Groups", req, &groups, a.ApiLevel)
 	return groups, err
 }
 
@@ -98,7 +98,8 @@ func ResourceGroup() common.Resource {
 			groupsAPI := NewGroupsAPI(ctx, c, common.GetApiLevel(d))
 			return groupsAPI.Delete(d.Id())
 		},
-		Schema: groupSchema,
+		Schema:       groupSchema,
+		WithIdentity: true,
 	}
 }
 
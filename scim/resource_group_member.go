@@ -110,6 +110,13 @@ func (gc *groupCache) addMember(api GroupsAPI, groupID string, memberID string)
 	return nil
 }
 
+// forget removes cached members of the group, e.g. after they're changed by databricks_group_members
+func (gc *groupCache) forget(groupID string) {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	delete(gc.cache, groupID)
+}
+
 func hasMember(members map[string]struct{}, memberID string) bool {
 	_, ok := members[memberID]
 	return ok
@@ -0,0 +1,154 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/databricks/terraform-provider-databricks/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// groupMembersPatchSize is the maximum number of members added or removed with a single SCIM PATCH request
+const groupMembersPatchSize = 100
+
+// groupMembersPatches returns SCIM PATCH requests that add and remove given members of a group
+func groupMembersPatches(add, remove []string) (patches []patchRequest) {
+	for start := 0; start < len(add); start += groupMembersPatchSize {
+		values := []ComplexValue{}
+		for _, memberID := range add[start:min(start+groupMembersPatchSize, len(add))] {
+			values = append(values, ComplexValue{Value: memberID})
+		}
+		patches = append(patches, PatchRequestComplexValue([]patchOperation{
+			{Op: "add", Path: "members", Value: values},
+		}))
+	}
+	for start := 0; start < len(remove); start += groupMembersPatchSize {
+		operations := []patchOperation{}
+		for _, memberID := range remove[start:min(start+groupMembersPatchSize, len(remove))] {
+			operations = append(operations, patchOperation{
+				Op:   "remove",
+				Path: fmt.Sprintf(`members[value eq "%s"]`, memberID),
+			})
+		}
+		patches = append(patches, PatchRequestComplexValue(operations))
+	}
+	return patches
+}
+
+// syncGroupMembers makes the given members the only members of the group
+func syncGroupMembers(api GroupsAPI, groupID string, desired map[string]bool) error {
+	group, err := api.Read(groupID, "members")
+	if err != nil {
+		return err
+	}
+	current := map[string]bool{}
+	for _, member := range group.Members {
+		current[member.Value] = true
+	}
+	var add, remove []string
+	for memberID := range desired {
+		if !current[memberID] {
+			add = append(add, memberID)
+		}
+	}
+	for memberID := range current {
+		if !desired[memberID] {
+			remove = append(remove, memberID)
+		}
+	}
+	return patchGroupMembers(api, groupID, add, remove)
+}
+
+func patchGroupMembers(api GroupsAPI, groupID string, add, remove []string) error {
+	sort.Strings(add)
+	sort.Strings(remove)
+	// members of the group could be cached by databricks_group_member resources
+	defer globalGroupsCache.forget(groupID)
+	for _, patch := range groupMembersPatches(add, remove) {
+		if err := api.Patch(groupID, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func membersFromData(d *schema.ResourceData) map[string]bool {
+	members := map[string]bool{}
+	for _, memberID := range d.Get("members").(*schema.Set).List() {
+		members[memberID.(string)] = true
+	}
+	return members
+}
+
+// ResourceGroupMembers authoritatively manages all members of a group
+func ResourceGroupMembers() common.Resource {
+	s := map[string]*schema.Schema{
+		"group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"members": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	common.AddApiField(s)
+	common.AddNamespaceInSchema(s)
+	common.NamespaceCustomizeSchemaMap(s)
+	return common.Resource{
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c *common.DatabricksClient) error {
+			return common.NamespaceCustomizeDiff(ctx, d, c)
+		},
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			c, err := c.DatabricksClientForUnifiedProvider(ctx, d)
+			if err != nil {
+				return err
+			}
+			groupID := d.Get("group_id").(string)
+			err = syncGroupMembers(NewGroupsAPI(ctx, c, common.GetApiLevel(d)), groupID, membersFromData(d))
+			if err != nil {
+				return err
+			}
+			d.SetId(groupID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			c, err := c.DatabricksClientForUnifiedProvider(ctx, d)
+			if err != nil {
+				return err
+			}
+			group, err := NewGroupsAPI(ctx, c, common.GetApiLevel(d)).Read(d.Id(), "members")
+			if err != nil {
+				return err
+			}
+			members := []string{}
+			for _, member := range group.Members {
+				members = append(members, member.Value)
+			}
+			d.Set("group_id", d.Id())
+			return d.Set("members", members)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			c, err := c.DatabricksClientForUnifiedProvider(ctx, d)
+			if err != nil {
+				return err
+			}
+			return syncGroupMembers(NewGroupsAPI(ctx, c, common.GetApiLevel(d)), d.Id(), membersFromData(d))
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			c, err := c.DatabricksClientForUnifiedProvider(ctx, d)
+			if err != nil {
+				return err
+			}
+			var remove []string
+			for memberID := range membersFromData(d) {
+				remove = append(remove, memberID)
+			}
+			return patchGroupMembers(NewGroupsAPI(ctx, c, common.GetApiLevel(d)), d.Id(), nil, remove)
+		},
+	}
+}
@@ -0,0 +1,185 @@
+package scim
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/databricks/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGroupMembersCreate(t *testing.T) {
+	globalGroupsCache = newGroupCache()
+	globalGroupsCache.getOrCreateGroupInfo("abc").initialized = true
+
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Response: Group{
+					ID:      "abc",
+					Members: []ComplexValue{{Value: "a"}, {Value: "x"}},
+				},
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: PatchRequestComplexValue([]patchOperation{
+					{Op: "add", Path: "members", Value: []ComplexValue{{Value: "b"}, {Value: "c"}}},
+				}),
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: PatchRequest("remove", `members[value eq "x"]`),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Response: Group{
+					ID:      "abc",
+					Members: []ComplexValue{{Value: "a"}, {Value: "b"}, {Value: "c"}},
+				},
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		HCL: `
+		group_id = "abc"
+		members = ["a", "b", "c"]
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 3, d.Get("members.#"))
+	_, cached := globalGroupsCache.cache["abc"]
+	assert.False(t, cached, "members cached by databricks_group_member must be forgotten")
+}
+
+func TestResourceGroupMembersRead(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Response: Group{
+					ID:      "abc",
+					Members: []ComplexValue{{Value: "a"}, {Value: "b"}},
+				},
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+	}.ApplyAndExpectData(t, map[string]any{
+		"group_id":  "abc",
+		"members.#": 2,
+	})
+}
+
+func TestResourceGroupMembersRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Status:   404,
+				Response: map[string]string{"detail": "Group not found"},
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceGroupMembersUpdate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Response: Group{
+					ID:      "abc",
+					Members: []ComplexValue{{Value: "a"}, {Value: "b"}},
+				},
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: PatchRequestWithValue("add", "members", "c"),
+			},
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: PatchRequestComplexValue([]patchOperation{
+					{Op: "remove", Path: `members[value eq "a"]`},
+					{Op: "remove", Path: `members[value eq "b"]`},
+				}),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc?attributes=members",
+				Response: Group{
+					ID:      "abc",
+					Members: []ComplexValue{{Value: "c"}},
+				},
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"group_id": "abc",
+		},
+		HCL: `
+		group_id = "abc"
+		members = ["c"]
+		`,
+	}.ApplyAndExpectData(t, map[string]any{
+		"members.#": 1,
+	})
+}
+
+func TestResourceGroupMembersDelete(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: PatchRequestComplexValue([]patchOperation{
+					{Op: "remove", Path: `members[value eq "a"]`},
+					{Op: "remove", Path: `members[value eq "b"]`},
+				}),
+			},
+		},
+		Resource: ResourceGroupMembers(),
+		Delete:   true,
+		ID:       "abc",
+		HCL: `
+		group_id = "abc"
+		members = ["a", "b"]
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestGroupMembersPatches(t *testing.T) {
+	var add, remove []string
+	for i := 0; i < groupMembersPatchSize+1; i++ {
+		add = append(add, fmt.Sprintf("a%d", i))
+		remove = append(remove, fmt.Sprintf("r%d", i))
+	}
+	patches := groupMembersPatches(add, remove)
+	assert.Len(t, patches, 4)
+	assert.Len(t, patches[0].Operations, 1)
+	assert.Len(t, patches[0].Operations[0].Value, groupMembersPatchSize)
+	assert.Equal(t, []ComplexValue{{Value: fmt.Sprintf("a%d", groupMembersPatchSize)}}, patches[1].Operations[0].Value)
+	assert.Len(t, patches[2].Operations, groupMembersPatchSize)
+	assert.Equal(t, []patchOperation{
+		{Op: "remove", Path: fmt.Sprintf(`members[value eq "r%d"]`, groupMembersPatchSize)},
+	}, patches[3].Operations)
+	assert.Empty(t, groupMembersPatches(nil, nil))
+}
@@ -262,6 +262,7 @@ func ResourceServicePrincipal() common.Resource {
 			}
 			return nil
 		},
+		WithIdentity: true,
 	}
 }
 
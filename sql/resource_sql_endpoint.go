@@ -3,6 +3,7 @@ package sql
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go"
@@ -54,6 +55,11 @@ func resolveDataSourceID(ctx context.Context, w *databricks.WorkspaceClient, war
 	return "", fmt.Errorf("no data source found for endpoint %s", warehouseId)
 }
 
+// ListWarehouses lists the SQL warehouses of the workspace.
+func ListWarehouses(ctx context.Context, w *databricks.WorkspaceClient) iter.Seq2[sql.EndpointInfo, error] {
+	return common.ListingSeq(ctx, w.Warehouses.List(ctx, sql.ListWarehousesRequest{}), nil)
+}
+
 func ResourceSqlEndpoint() common.Resource {
 	s := common.StructToSchema(SqlWarehouse{}, func(
 		m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -184,5 +190,6 @@ func ResourceSqlEndpoint() common.Resource {
 			}
 			return d.Clear("health")
 		},
+		WithIdentity: true,
 	}
 }
@@ -278,6 +278,15 @@ func readMountSource(ctx context.Context, mp MountPoint, d *schema.ResourceData)
 // return resource reader function
 func mountRead(tpl any, r common.Resource) func(context.Context, *schema.ResourceData, *common.DatabricksClient) error {
 	return func(ctx context.Context, d *schema.ResourceData, m *common.DatabricksClient) error {
+		if m.IsReadOnly() {
+			// mounts are read by commands on a cluster that is started if needed, and both are rejected
+			// in read-only mode, so the state is kept as it is
+			return &common.ReadSkippedError{
+				Reason: "Mounts are read by executing commands on a cluster, which isn't allowed when the provider " +
+					"is configured with read_only = true. The mount is kept as it is in the state, so changes made " +
+					"outside of Terraform aren't detected.",
+			}
+		}
 		_, mp, err := mountCluster(ctx, tpl, d, m, r)
 		if err != nil {
 			return err
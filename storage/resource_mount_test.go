@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -404,6 +405,36 @@ func TestResourceAwsS3MountGenericRead(t *testing.T) {
 	assert.Equal(t, testS3BucketPath, d.Get("source"))
 }
 
+func TestResourceMountRead_ReadOnly(t *testing.T) {
+	// only the cluster is fetched, so any attempt to start it or to execute a command fails
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+			Response: clusters.ClusterInfo{
+				State: clusters.ClusterStateTerminated,
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer server.Close()
+	client.SetReadOnly(true)
+
+	r := ResourceMount()
+	d := r.ToResource().TestResourceData()
+	d.SetId("this_mount")
+	d.Set("cluster_id", "this_cluster")
+	d.Set("name", "this_mount")
+	d.Set("uri", "abfss://container@account.dfs.core.windows.net/")
+	d.Set("source", "abfss://container@account.dfs.core.windows.net/")
+	err = r.Read(context.Background(), d, client)
+	var skipped *common.ReadSkippedError
+	require.ErrorAs(t, err, &skipped)
+	assert.Equal(t, "this_mount", d.Id())
+	assert.Equal(t, "abfss://container@account.dfs.core.windows.net/", d.Get("source"))
+}
+
 func TestResourceAwsS3MountGenericRead_NotFound(t *testing.T) {
 	qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{